@@ -0,0 +1,226 @@
+package protoredact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// RedactClone returns a redacted copy of msg, msg itself stays untouched.
+// Only non-sensitive data is copied: sensitive messages, lists and maps are left unset in the copy
+// before the handler is called, sensitive scalars are copied, redacted map entries get zero value.
+// HandlerContext.Value is the value of msg in all cases.
+func (r Redactor) RedactClone(msg proto.Message) (proto.Message, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	handler := r.handler()
+	if r.annotations().empty() || handler == nil {
+		return proto.Clone(msg), nil
+	}
+	src := msg.ProtoReflect()
+	if !src.IsValid() {
+		return proto.Clone(msg), nil
+	}
+	dst := src.New()
+	c := cloner{redactor: r, handler: handler, entryHandler: r.mapEntryHandler(), enumHandler: r.enumValueHandler()}
+	if err := c.message(rootPath(src), dst, src); err != nil {
+		return nil, err
+	}
+	return dst.Interface(), nil
+}
+
+// cloner copies non-sensitive data and calls handlers on the copy
+type cloner struct {
+	redactor     Redactor
+	handler      Handler
+	entryHandler Handler
+	enumHandler  Handler
+}
+
+type pendingHandle struct {
+	handler Handler
+	c       HandlerContext
+}
+
+func (c cloner) message(path protopath.Values, dst, src protoreflect.Message) error {
+	p := planFor(src.Descriptor(), c.redactor.annotations())
+	if !p.reachable {
+		proto.Merge(dst.Interface(), src.Interface())
+		return nil
+	}
+	var (
+		pending []pendingHandle
+		err     error
+	)
+	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		fp := p.field(fd)
+		fieldPath := appendStep(path, protopath.FieldAccess(fd), v)
+		if fp.keep {
+			// merging a message holding only fd deep copies the field
+			field := src.New()
+			field.Set(fd, v)
+			proto.Merge(dst.Interface(), field.Interface())
+			return true
+		}
+		if fp.sensitive {
+			c.redactor.logRedacted(fieldPath.Path, fp.reason)
+			if fp.hideVariant {
+				return true
+			}
+			if !fd.IsList() && !fd.IsMap() && fd.Message() == nil {
+				dst.Set(fd, copyScalar(v))
+			}
+			pending = append(pending, pendingHandle{handler: c.handler, c: HandlerContext{
+				Values:     copyValues(fieldPath),
+				Parent:     dst,
+				Field:      fd,
+				Annotation: fp.annotation,
+				ListIndex:  -1,
+				Value:      v,
+			}})
+			return true
+		}
+		if fp.redactKeys {
+			// keys are rewritten before the values are redacted as Redact does, so handlers and logs never see
+			// original keys in paths; this copies the whole map before redacting it in place
+			field := src.New()
+			field.Set(fd, v)
+			proto.Merge(dst.Interface(), field.Interface())
+			copied := dst.Get(fd)
+			err = c.redactor.inPlace().field(appendStep(path, protopath.FieldAccess(fd), copied), dst, fd, fp, copied)
+			return err == nil
+		}
+		var copied protoreflect.Value
+		copied, err = c.value(fieldPath, dst, fd, v, fp)
+		if err != nil {
+			return false
+		}
+		dst.Set(fd, copied)
+		if len(fp.enumValues) > 0 {
+			enumValues := walker{redactor: c.redactor, visit: func(loc location) error {
+				c.redactor.logRedacted(loc.path.Path, loc.reason)
+				pending = append(pending, pendingHandle{handler: c.enumHandler, c: HandlerContext{
+					Values:     copyValues(loc.path),
+					Parent:     dst,
+					Field:      fd,
+					Annotation: loc.annotation(),
+					MapKey:     loc.key,
+					ListIndex:  loc.index,
+					Value:      loc.path.Index(-1).Value,
+				}})
+				return nil
+			}}
+			if err = enumValues.enumValues(fieldPath, src, fd, fp, v); err != nil {
+				return false
+			}
+		}
+		if !fp.byEntry() {
+			return true
+		}
+		v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			if !fp.matchValue(key.String()) {
+				return true
+			}
+			entryPath := appendStep(fieldPath, protopath.MapIndex(key), value)
+			if fp.valuesOnly {
+				entry := copied.Map().Get(key).Message()
+				err = fp.rangeValueFields(entryPath, value.Message(), func(path protopath.Values, _ protoreflect.Message, fields []protoreflect.FieldDescriptor) error {
+					// the copy holds the same non-sensitive path as src
+					parent := entry
+					for _, fd := range fields[:len(fields)-1] {
+						if !parent.Has(fd) {
+							return nil
+						}
+						parent = parent.Get(fd).Message()
+					}
+					c.redactor.logRedacted(path.Path, reasonMapValueField)
+					pending = append(pending, pendingHandle{handler: c.handler, c: HandlerContext{
+						Values:     copyValues(path),
+						Parent:     parent,
+						Field:      fields[len(fields)-1],
+						Annotation: fp.annotation,
+						ListIndex:  -1,
+						Value:      path.Index(-1).Value,
+					}})
+					return nil
+				})
+				return err == nil
+			}
+			c.redactor.logRedacted(entryPath.Path, reasonMapKey)
+			pending = append(pending, pendingHandle{handler: c.entryHandler, c: HandlerContext{
+				Values:     copyValues(entryPath),
+				Parent:     dst,
+				Field:      fd,
+				Annotation: fp.annotation,
+				MapKey:     key,
+				ListIndex:  -1,
+				Value:      value,
+			}})
+			return true
+		})
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(src.GetUnknown()) > 0 && !c.redactor.Allowlist {
+		dst.SetUnknown(append(protoreflect.RawFields(nil), src.GetUnknown()...))
+	}
+	for _, h := range pending {
+		if err := h.handler.Handle(h.c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// value copies v of fd, map entries redacted as a whole by fp get zero value
+func (c cloner) value(path protopath.Values, dst protoreflect.Message, fd protoreflect.FieldDescriptor, v protoreflect.Value, fp fieldPlan) (protoreflect.Value, error) {
+	switch {
+	case fd.IsList():
+		list := dst.NewField(fd).List()
+		for i := 0; i < v.List().Len(); i++ {
+			elem, err := c.element(appendStep(path, protopath.ListIndex(i), v.List().Get(i)), list.NewElement, fd.Message(), v.List().Get(i))
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			list.Append(elem)
+		}
+		return protoreflect.ValueOfList(list), nil
+	case fd.IsMap():
+		m := dst.NewField(fd).Map()
+		var err error
+		v.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			if !fp.valuesOnly && fp.matchValue(key.String()) {
+				m.Set(key, m.NewValue())
+				return true
+			}
+			var elem protoreflect.Value
+			elem, err = c.element(appendStep(path, protopath.MapIndex(key), value), m.NewValue, fd.MapValue().Message(), value)
+			if err != nil {
+				return false
+			}
+			m.Set(key, elem)
+			return true
+		})
+		return protoreflect.ValueOfMap(m), err
+	default:
+		return c.element(path, func() protoreflect.Value { return dst.NewField(fd) }, fd.Message(), v)
+	}
+}
+
+func (c cloner) element(path protopath.Values, newValue func() protoreflect.Value, md protoreflect.MessageDescriptor, v protoreflect.Value) (protoreflect.Value, error) {
+	if md == nil {
+		return copyScalar(v), nil
+	}
+	m := newValue()
+	return m, c.message(path, m.Message(), v.Message())
+}
+
+func copyScalar(v protoreflect.Value) protoreflect.Value {
+	if b, ok := v.Interface().([]byte); ok {
+		return protoreflect.ValueOfBytes(append([]byte(nil), b...))
+	}
+	return v
+}
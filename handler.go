@@ -0,0 +1,144 @@
+package protoredact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Handler decides what happens with a sensitive value
+type Handler interface {
+	Handle(c HandlerContext) error
+}
+
+// HandlerFunc adapts a function to Handler
+type HandlerFunc func(c HandlerContext) error
+
+func (f HandlerFunc) Handle(c HandlerContext) error {
+	return f(c)
+}
+
+// HandlerContext describes the sensitive value passed to Handler
+type HandlerContext struct {
+	// Values leads from the root message to Value
+	Values protopath.Values
+	// Parent is the message holding Field
+	Parent protoreflect.Message
+	// Field is the sensitive field
+	Field protoreflect.FieldDescriptor
+	// Annotation is the value of the annotation making the target sensitive:
+	// on Field, on its message type, on its file or on the enum value for EnumValueHandler
+	Annotation proto.Message
+	// MapKey is valid when the handler targets a single entry of the Field map
+	MapKey protoreflect.MapKey
+	// ListIndex is the index of the targeted element of the Field list, -1 when not a list element
+	ListIndex int
+	// Value is the current value of the target.
+	// RedactClone passes the value of the original message, so replace it with Set instead of mutating.
+	Value protoreflect.Value
+}
+
+// Set replaces the target value
+func (c HandlerContext) Set(v protoreflect.Value) {
+	switch {
+	case c.MapKey.IsValid():
+		c.Parent.Mutable(c.Field).Map().Set(c.MapKey, v)
+	case c.ListIndex >= 0:
+		c.Parent.Mutable(c.Field).List().Set(c.ListIndex, v)
+	default:
+		c.Parent.Set(c.Field, v)
+	}
+}
+
+// Clear clears the target field, map entries and list elements are set to zero value
+func (c HandlerContext) Clear() {
+	switch {
+	case c.MapKey.IsValid():
+		m := c.Parent.Mutable(c.Field).Map()
+		m.Set(c.MapKey, m.NewValue())
+	case c.ListIndex >= 0:
+		l := c.Parent.Mutable(c.Field).List()
+		l.Set(c.ListIndex, l.NewElement())
+	default:
+		c.Parent.Clear(c.Field)
+	}
+}
+
+// FieldHandler adapts func of Redactor.RedactingHandler to Handler.
+// f can handle only whole fields, so map entries and list elements are set to zero value.
+func FieldHandler(f func(parent protoreflect.Value, field protoreflect.FieldDescriptor) error) Handler {
+	return HandlerFunc(func(c HandlerContext) error {
+		if c.MapKey.IsValid() || c.ListIndex >= 0 {
+			c.Clear()
+			return nil
+		}
+		return f(protoreflect.ValueOfMessage(c.Parent), c.Field)
+	})
+}
+
+// Built-in Redactor.MapEntryHandler choices for map keys listed in map_keys_to_redact
+var (
+	// DeleteMapEntry removes the entry from the map
+	DeleteMapEntry Handler = HandlerFunc(func(c HandlerContext) error {
+		c.Parent.Mutable(c.Field).Map().Clear(c.MapKey)
+		return nil
+	})
+	// ZeroMapValue keeps the key with zero value, it is the default
+	ZeroMapValue Handler = HandlerFunc(func(c HandlerContext) error {
+		c.Clear()
+		return nil
+	})
+)
+
+// ReplaceEnumValue makes a Redactor.EnumValueHandler which sets sensitive enum values to n
+func ReplaceEnumValue(n protoreflect.EnumNumber) Handler {
+	return HandlerFunc(func(c HandlerContext) error {
+		c.Set(protoreflect.ValueOfEnum(n))
+		return nil
+	})
+}
+
+// ApplyToMapValue makes a Redactor.MapEntryHandler which applies h to the entry value.
+// For message values h is applied to every populated field of the value.
+func ApplyToMapValue(h Handler) Handler {
+	return HandlerFunc(func(c HandlerContext) error {
+		if c.Field.MapValue().Message() == nil {
+			return h.Handle(c)
+		}
+		entry := c.Parent.Mutable(c.Field).Map().Mutable(c.MapKey).Message()
+		var (
+			fields []protoreflect.FieldDescriptor
+			values []protoreflect.Value
+		)
+		c.Value.Message().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			fields = append(fields, fd)
+			values = append(values, v)
+			return true
+		})
+		for i, fd := range fields {
+			if !fd.IsList() && !fd.IsMap() && fd.Message() == nil {
+				// RedactClone passes an empty entry, copy scalars as for sensitive fields
+				entry.Set(fd, copyScalar(values[i]))
+			}
+			err := h.Handle(HandlerContext{
+				Values:     copyValues(appendStep(c.Values, protopath.FieldAccess(fd), values[i])),
+				Parent:     entry,
+				Field:      fd,
+				Annotation: c.Annotation,
+				ListIndex:  -1,
+				Value:      values[i],
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func copyValues(values protopath.Values) protopath.Values {
+	return protopath.Values{
+		Path:   append(protopath.Path(nil), values.Path...),
+		Values: append([]protoreflect.Value(nil), values.Values...),
+	}
+}
@@ -0,0 +1,128 @@
+package protoredact
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"testing"
+)
+
+func TestRedactor_Handler(t *testing.T) {
+	t.Parallel()
+	var paths []string
+	redactor := Redactor{
+		SensitiveFieldAnnotation: testproto.E_SensitiveData,
+		RedactingHandler: func(protoreflect.Value, protoreflect.FieldDescriptor) error {
+			t.Fatal("Handler must take precedence")
+			return nil
+		},
+		Handler: HandlerFunc(func(c HandlerContext) error {
+			paths = append(paths, c.Values.Path.String())
+			assert.IsType(t, &testproto.SensitiveData{}, c.Annotation)
+			assert.Equal(t, -1, c.ListIndex)
+			assert.False(t, c.MapKey.IsValid())
+			assert.Equal(t, c.Values.Index(-1).Value, c.Value)
+			if c.Values.Path.String() == "(testproto.WithAllFieldTypes).fieldStringSensitive" {
+				s := c.Value.String()
+				c.Set(protoreflect.ValueOfString("***" + s[len(s)-4:]))
+				return nil
+			}
+			c.Clear()
+			return nil
+		}),
+	}
+	message := &testproto.WithAllFieldTypes{
+		FieldStringSensitive: "4111111111111111",
+		MessageList:          []*testproto.WithAllFieldTypes_Internal{{FieldInt64: 145, FieldStringSensitive: "progress"}},
+	}
+	want := &testproto.WithAllFieldTypes{
+		FieldStringSensitive: "***1111",
+		MessageList:          []*testproto.WithAllFieldTypes_Internal{{FieldInt64: 145}},
+	}
+
+	cloned, err := redactor.RedactClone(message)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(want, cloned))
+
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(want, message))
+
+	assert.ElementsMatch(t, []string{
+		"(testproto.WithAllFieldTypes).fieldStringSensitive",
+		"(testproto.WithAllFieldTypes).messageList[0].fieldStringSensitive",
+		"(testproto.WithAllFieldTypes).fieldStringSensitive",
+		"(testproto.WithAllFieldTypes).messageList[0].fieldStringSensitive",
+	}, paths)
+}
+
+func TestRedactor_MapEntryHandler(t *testing.T) {
+	t.Parallel()
+	setStringClearOther := FieldHandler(func(parent protoreflect.Value, field protoreflect.FieldDescriptor) error {
+		if field.Kind() == protoreflect.StringKind {
+			parent.Message().Set(field, protoreflect.ValueOfString("REDACTED"))
+		} else {
+			parent.Message().Clear(field)
+		}
+		return nil
+	})
+	message := &testproto.WithAllFieldTypes_Internal{
+		MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+			"detail":        {FieldInt64: 948},
+			"hide_this_key": {FieldInt64: 999, FieldStringSensitive: "Conubiafeugiat", Recursive: &testproto.WithAllFieldTypes_Internal{FieldInt64: 1}},
+		},
+	}
+	tests := []struct {
+		name            string
+		mapEntryHandler Handler
+		want            *testproto.WithAllFieldTypes_Internal
+	}{
+		{
+			name: "default",
+			want: &testproto.WithAllFieldTypes_Internal{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+				"detail":        {FieldInt64: 948},
+				"hide_this_key": {},
+			}},
+		},
+		{
+			name:            "zero",
+			mapEntryHandler: ZeroMapValue,
+			want: &testproto.WithAllFieldTypes_Internal{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+				"detail":        {FieldInt64: 948},
+				"hide_this_key": {},
+			}},
+		},
+		{
+			name:            "delete",
+			mapEntryHandler: DeleteMapEntry,
+			want: &testproto.WithAllFieldTypes_Internal{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+				"detail": {FieldInt64: 948},
+			}},
+		},
+		{
+			name:            "apply field handler",
+			mapEntryHandler: ApplyToMapValue(setStringClearOther),
+			want: &testproto.WithAllFieldTypes_Internal{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+				"detail":        {FieldInt64: 948},
+				"hide_this_key": {FieldStringSensitive: "REDACTED"},
+			}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redactor := Redactor{
+				SensitiveFieldAnnotation: testproto.E_SensitiveData,
+				Handler:                  setStringClearOther,
+				MapEntryHandler:          tt.mapEntryHandler,
+			}
+
+			cloned, err := redactor.RedactClone(message)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, cloned), cloned)
+
+			inPlace := proto.Clone(message)
+			assert.NoError(t, redactor.Redact(inPlace))
+			assert.True(t, proto.Equal(tt.want, inPlace), inPlace)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// annotationField returns the value of the field name of annotation if it is set and has the kind
+func annotationField(annotation proto.Message, name protoreflect.Name, kind protoreflect.Kind) (protoreflect.Value, bool) {
+	if annotation == nil {
+		return protoreflect.Value{}, false
+	}
+	m := annotation.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || fd.IsList() || fd.Kind() != kind || !m.Has(fd) {
+		return protoreflect.Value{}, false
+	}
+	return m.Get(fd), true
+}
+
+// annotationEnum returns the value name of the enum field name of annotation, empty if not set
+func annotationEnum(annotation proto.Message, name protoreflect.Name) string {
+	v, ok := annotationField(annotation, name, protoreflect.EnumKind)
+	if !ok {
+		return ""
+	}
+	fd := annotation.ProtoReflect().Descriptor().Fields().ByName(name)
+	value := fd.Enum().Values().ByNumber(v.Enum())
+	if value == nil {
+		return ""
+	}
+	return string(value.Name())
+}
+
+// enumChoice matches an enum value name against choices ignoring the enum prefix,
+// so both FORMAT_CARD and CARD select CARD, empty if nothing matches
+func enumChoice(name string, choices ...string) string {
+	for _, choice := range choices {
+		if name == choice || strings.HasSuffix(name, "_"+choice) {
+			return choice
+		}
+	}
+	return ""
+}
@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"github.com/yonesko/protoredact"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// KeyRing holds AES keys by id. Current encrypts, every key decrypts, so rotated keys keep decrypting.
+type KeyRing struct {
+	Current string
+	// Keys are 16, 24 or 32 bytes long, ids must not contain ':'
+	Keys map[string][]byte
+}
+
+func (k KeyRing) aead(keyID string) (cipher.AEAD, error) {
+	key, ok := k.Keys[keyID]
+	if !ok {
+		return nil, fmt.Errorf("handlers: unknown key id %q", keyID)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("handlers: key %q: %w", keyID, err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt replaces strings and bytes with keyID:nonce:ciphertext sealed by AES-GCM under the current key,
+// nonce and ciphertext are base64url encoded, the field number is authenticated as additional data.
+// Field, message and package names are not, so renaming them keeps archived ciphertexts recoverable.
+// Other kinds cannot hold the ciphertext, Encrypt fails on them instead of losing data.
+func Encrypt(keys KeyRing) protoredact.Handler {
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		if err := checkText("Encrypt", c.Field); err != nil {
+			return err
+		}
+		aead, err := keys.aead(keys.Current)
+		if err != nil {
+			return err
+		}
+		return Each(c, func(c protoredact.HandlerContext) error {
+			nonce := make([]byte, aead.NonceSize())
+			if _, err := rand.Read(nonce); err != nil {
+				return err
+			}
+			sealed := aead.Seal(nil, nonce, textBytes(c.Value), additionalData(c.Field))
+			c.Set(textValue(c.Field, keys.Current+":"+
+				base64.RawURLEncoding.EncodeToString(nonce)+":"+
+				base64.RawURLEncoding.EncodeToString(sealed)))
+			return nil
+		})
+	})
+}
+
+// Decrypt reverses Encrypt, empty values are left as is
+func Decrypt(keys KeyRing) protoredact.Handler {
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		if err := checkText("Decrypt", c.Field); err != nil {
+			return err
+		}
+		return Each(c, func(c protoredact.HandlerContext) error {
+			encrypted := string(textBytes(c.Value))
+			if encrypted == "" {
+				return nil
+			}
+			parts := strings.Split(encrypted, ":")
+			if len(parts) != 3 {
+				return fmt.Errorf("handlers: %s is not encrypted", c.Values.Path)
+			}
+			aead, err := keys.aead(parts[0])
+			if err != nil {
+				return err
+			}
+			nonce, err := base64.RawURLEncoding.DecodeString(parts[1])
+			if err != nil {
+				return fmt.Errorf("handlers: %s: nonce: %w", c.Values.Path, err)
+			}
+			sealed, err := base64.RawURLEncoding.DecodeString(parts[2])
+			if err != nil {
+				return fmt.Errorf("handlers: %s: ciphertext: %w", c.Values.Path, err)
+			}
+			if len(nonce) != aead.NonceSize() {
+				return fmt.Errorf("handlers: %s: invalid nonce size", c.Values.Path)
+			}
+			plain, err := aead.Open(nil, nonce, sealed, additionalData(c.Field))
+			if err != nil {
+				return fmt.Errorf("handlers: %s: %w", c.Values.Path, err)
+			}
+			c.Set(textValue(c.Field, string(plain)))
+			return nil
+		})
+	})
+}
+
+// additionalData binds a ciphertext to the number of the field holding it, which stays the same on wire-compatible changes
+func additionalData(fd protoreflect.FieldDescriptor) []byte {
+	return protowire.AppendVarint(nil, uint64(fd.Number()))
+}
+
+// Encrypting returns r encrypting sensitive values, map entries listed in map_keys_to_redact
+// and keys of redact_map_keys maps, so Unredactor can recover all of them.
+// Sensitive enum values are still replaced by EnumValueHandler and cannot be recovered
+func Encrypting(r protoredact.Redactor, keys KeyRing) protoredact.Redactor {
+	r.RedactingHandler = nil
+	r.Handler = Encrypt(keys)
+	r.MapEntryHandler = protoredact.ApplyToMapValue(r.Handler)
+	r.MapKeyHandler = r.Handler
+	return r
+}
+
+// Unredactor decrypts values encrypted by Encrypting in the fields Redactor selects.
+// Handlers of Redactor are replaced, map entries listed in map_keys_to_redact are decrypted with ApplyToMapValue
+// and fail if they were cleared instead of encrypted,
+// encrypted keys of redact_map_keys maps are decrypted, other keys and enum values are kept.
+type Unredactor struct {
+	Redactor protoredact.Redactor
+	Keys     KeyRing
+}
+
+func (u Unredactor) Unredact(msg proto.Message) error {
+	r := u.Redactor
+	r.RedactingHandler = nil
+	r.Handler = Decrypt(u.Keys)
+	r.MapEntryHandler = restoreEntries("Encrypting", r.Handler)
+	r = r.RestoringMapKeys(restoreKeys(r.Handler, func(key string) bool {
+		parts := strings.Split(key, ":")
+		_, ok := u.Keys.Keys[parts[0]]
+		return len(parts) == 3 && ok
+	}))
+	r.EnumValueHandler = keep
+	return r.Redact(msg)
+}
+
+// keep leaves the target as is, it stands in for redaction which cannot be reversed
+var keep protoredact.Handler = protoredact.HandlerFunc(func(protoredact.HandlerContext) error {
+	return nil
+})
+
+// restoreEntries applies h to map values as ApplyToMapValue does. Empty string and bytes values fail:
+// reversible handlers never produce them, so the entry was cleared by another MapEntryHandler and is lost
+func restoreEntries(redactor string, h protoredact.Handler) protoredact.Handler {
+	apply := protoredact.ApplyToMapValue(h)
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		if checkText("", c.Field.MapValue()) == nil && len(textBytes(c.Value)) == 0 {
+			return fmt.Errorf("handlers: %s is empty and cannot be restored, redact with %s", c.Values.Path, redactor)
+		}
+		return apply.Handle(c)
+	})
+}
+
+// restoreKeys applies h to string and bytes map keys which are redacted, others are kept:
+// they were not selected by the rule or were rewritten irreversibly
+func restoreKeys(h protoredact.Handler, redacted func(key string) bool) protoredact.Handler {
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		if checkText("", c.Field) != nil || !redacted(string(textBytes(c.Value))) {
+			return nil
+		}
+		return h.Handle(c)
+	})
+}
+
+func checkText(handler string, fd protoreflect.FieldDescriptor) error {
+	switch kind := valueField(fd).Kind(); kind {
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return nil
+	default:
+		return fmt.Errorf("handlers: %s supports only strings and bytes, %s is %s", handler, fd.FullName(), kind)
+	}
+}
+
+func textBytes(v protoreflect.Value) []byte {
+	if b, ok := v.Interface().([]byte); ok {
+		return b
+	}
+	return []byte(v.String())
+}
+
+func textValue(fd protoreflect.FieldDescriptor, s string) protoreflect.Value {
+	if valueField(fd).Kind() == protoreflect.BytesKind {
+		return protoreflect.ValueOfBytes([]byte(s))
+	}
+	return protoreflect.ValueOfString(s)
+}
@@ -0,0 +1,126 @@
+package handlers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"strings"
+	"testing"
+)
+
+func TestEncrypt(t *testing.T) {
+	t.Parallel()
+	oldKeys := KeyRing{Current: "k1", Keys: map[string][]byte{"k1": []byte("0123456789abcdef")}}
+	rotatedKeys := KeyRing{Current: "k2", Keys: map[string][]byte{
+		"k1": []byte("0123456789abcdef"),
+		"k2": []byte("0123456789abcdef0123456789abcdef"),
+	}}
+	newMessage := func() *testproto.Scalars {
+		return &testproto.Scalars{
+			FieldString:      "4111111111111111",
+			FieldBytes:       []byte("secret"),
+			StringList:       []string{"alpha", "be"},
+			StringMap:        map[string]string{"k": "value"},
+			Headers:          map[string]string{"authorization": "Bearer token", "accept": "*/*"},
+			FieldStringPlain: "plain",
+		}
+	}
+	redactor := func(keys KeyRing) protoredact.Redactor {
+		return Encrypting(protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData}, keys)
+	}
+
+	oldMessage := newMessage()
+	assert.NoError(t, redactor(oldKeys).Redact(oldMessage))
+	assert.True(t, strings.HasPrefix(oldMessage.FieldString, "k1:"))
+	assert.True(t, strings.HasPrefix(string(oldMessage.FieldBytes), "k1:"))
+	assert.True(t, strings.HasPrefix(oldMessage.StringList[1], "k1:"))
+	assert.True(t, strings.HasPrefix(oldMessage.StringMap["k"], "k1:"))
+	assert.True(t, strings.HasPrefix(oldMessage.Headers["authorization"], "k1:"))
+	assert.Equal(t, "*/*", oldMessage.Headers["accept"])
+	assert.Equal(t, "plain", oldMessage.FieldStringPlain)
+	assert.NotContains(t, oldMessage.String(), "4111111111111111")
+
+	newMessageEncrypted := newMessage()
+	assert.NoError(t, redactor(rotatedKeys).Redact(newMessageEncrypted))
+	assert.True(t, strings.HasPrefix(newMessageEncrypted.FieldString, "k2:"))
+
+	unredactor := Unredactor{Redactor: protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData}, Keys: rotatedKeys}
+	assert.NoError(t, unredactor.Unredact(oldMessage))
+	assert.True(t, proto.Equal(newMessage(), oldMessage), oldMessage)
+	assert.NoError(t, unredactor.Unredact(newMessageEncrypted))
+	assert.True(t, proto.Equal(newMessage(), newMessageEncrypted), newMessageEncrypted)
+
+	newMessageEncrypted = newMessage()
+	assert.NoError(t, redactor(rotatedKeys).Redact(newMessageEncrypted))
+	oldUnredactor := Unredactor{Redactor: protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData}, Keys: oldKeys}
+	assert.EqualError(t, oldUnredactor.Unredact(newMessageEncrypted), `handlers: unknown key id "k2"`)
+
+	// ciphertexts survive renaming the field, its message and package
+	encrypted := newMessage()
+	assert.NoError(t, redactor(rotatedKeys).Redact(encrypted))
+	number := encrypted.ProtoReflect().Descriptor().Fields().ByName("fieldString").Number()
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:    proto.String("renamed.proto"),
+		Package: proto.String("renamed"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Renamed"),
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:   proto.String("pan"),
+				Number: proto.Int32(int32(number)),
+				Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				Label:  descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			}},
+		}},
+	}, nil)
+	assert.NoError(t, err)
+	renamed := dynamicpb.NewMessage(file.Messages().Get(0))
+	pan := renamed.Descriptor().Fields().Get(0)
+	renamed.Set(pan, protoreflect.ValueOfString(encrypted.FieldString))
+	assert.NoError(t, Decrypt(rotatedKeys).Handle(protoredact.HandlerContext{Parent: renamed, Field: pan, ListIndex: -1, Value: renamed.Get(pan)}))
+	assert.Equal(t, "4111111111111111", renamed.Get(pan).String())
+
+	assert.EqualError(t, redactor(oldKeys).Redact(&testproto.Scalars{FieldInt64: 42}),
+		"handlers: Encrypt supports only strings and bytes, testproto.Scalars.fieldInt64 is int64")
+	assert.EqualError(t, unredactor.Unredact(&testproto.Scalars{FieldString: "4111111111111111"}),
+		"handlers: (testproto.Scalars).fieldString is not encrypted")
+
+	// entries zeroed by the default MapEntryHandler are reported
+	zeroed := newMessage()
+	assert.NoError(t, protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: Encrypt(rotatedKeys)}.Redact(zeroed))
+	assert.EqualError(t, unredactor.Unredact(zeroed),
+		`handlers: (testproto.Scalars).headers["authorization"] is empty and cannot be restored, redact with Encrypting`)
+}
+
+func TestUnredactor_MapKeys(t *testing.T) {
+	t.Parallel()
+	keys := KeyRing{Current: "k1", Keys: map[string][]byte{"k1": []byte("0123456789abcdef")}}
+	newStats := func() *testproto.UserStats {
+		return &testproto.UserStats{
+			PerUser:    map[string]*testproto.Plain{"bob@example.com": {FieldString: "secret", FieldInt64: 1}},
+			PerContact: map[string]int64{"bob@example.com": 1, "local": 2},
+		}
+	}
+	sensitive := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData}
+	redactor := Encrypting(sensitive, keys)
+
+	message := newStats()
+	assert.NoError(t, redactor.Redact(message))
+	assert.NotContains(t, message.String(), "bob@example.com")
+	unredactor := Unredactor{Redactor: sensitive, Keys: keys}
+	assert.NoError(t, unredactor.Unredact(message))
+	assert.True(t, proto.Equal(newStats(), message), message)
+
+	// keys which cannot hold ciphertexts are kept
+	redactor.MapKeyHandler = HMAC{Key: []byte("key")}
+	phones := &testproto.UserStats{PerPhone: map[int64]*testproto.Plain{79001234567: {FieldInt64: 1}}}
+	assert.NoError(t, redactor.Redact(phones))
+	hashed := proto.Clone(phones)
+	assert.NoError(t, unredactor.Unredact(phones))
+	assert.True(t, proto.Equal(hashed, phones), phones)
+}
@@ -0,0 +1,209 @@
+package handlers
+
+import (
+	"github.com/yonesko/protoredact"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// formats are chosen by the name of the annotation `format` enum value, see enumChoice
+var formats = map[string]func(string) string{
+	"CARD":  MaskCard,
+	"EMAIL": MaskEmail,
+	"PHONE": MaskPhone,
+	"IBAN":  MaskIBAN,
+}
+
+// FormatPreserving keeps the shape of well-known PII so redacted values still pass format validation.
+// The format comes from the `format` enum field of the annotation: CARD, EMAIL, PHONE or IBAN.
+// Fields without a format and values other than strings and bytes go to fallback.
+func FormatPreserving(fallback protoredact.Handler) protoredact.Handler {
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		mask, ok := formats[enumChoice(annotationEnum(c.Annotation, "format"), "CARD", "EMAIL", "PHONE", "IBAN")]
+		if !ok || checkText("FormatPreserving", c.Field) != nil {
+			return fallback.Handle(c)
+		}
+		return Each(c, func(c protoredact.HandlerContext) error {
+			c.Set(textValue(c.Field, mask(string(textBytes(c.Value)))))
+			return nil
+		})
+	})
+}
+
+// MaskCard keeps the first 6 (BIN) and the last 4 digits, the rest become zeros with one digit chosen
+// to keep the number Luhn-valid. Separators are kept, numbers shorter than 12 digits are zeroed entirely.
+func MaskCard(s string) string {
+	runes := []rune(s)
+	var digits []int
+	for i, r := range runes {
+		if isDigit(r) {
+			digits = append(digits, i)
+		}
+	}
+	if len(digits) < 12 {
+		return zeroDigits(s)
+	}
+	middle := digits[6 : len(digits)-4]
+	for _, i := range middle {
+		runes[i] = '0'
+	}
+	fix := middle[len(middle)-1]
+	for d := '0'; d <= '9'; d++ {
+		runes[fix] = d
+		if luhnValid(runes) {
+			break
+		}
+	}
+	return string(runes)
+}
+
+// MaskEmail keeps the first character of the local part and the domain: j***@example.com
+func MaskEmail(s string) string {
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 {
+		return strings.Repeat(string(DefaultMaskChar), utf8.RuneCountInString(s))
+	}
+	first, _ := utf8.DecodeRuneInString(s)
+	return string(first) + strings.Repeat(string(DefaultMaskChar), 3) + s[at:]
+}
+
+// MaskPhone keeps the leading '+' with the country code and separators, other digits become zeros.
+// Numbers without '+' are zeroed entirely.
+func MaskPhone(s string) string {
+	trimmed := strings.TrimLeftFunc(s, unicode.IsSpace)
+	if !strings.HasPrefix(trimmed, "+") {
+		return zeroDigits(s)
+	}
+	prefix := len(s) - len(trimmed) + 1
+	var code []rune
+	for _, r := range s[prefix:] {
+		if !isDigit(r) || len(code) == 3 {
+			break
+		}
+		code = append(code, r)
+	}
+	n := countryCodeLength(string(code))
+	kept := 0
+	runes := []rune(s[prefix:])
+	for i, r := range runes {
+		if !isDigit(r) {
+			continue
+		}
+		if kept < n {
+			kept++
+			continue
+		}
+		runes[i] = '0'
+	}
+	return s[:prefix] + string(runes)
+}
+
+// MaskIBAN keeps the country and check digits, BBAN digits become zeros and letters become 'X',
+// the last two BBAN digits are chosen to keep mod-97 check valid. Separators are kept.
+func MaskIBAN(s string) string {
+	runes := []rune(strings.ToUpper(s))
+	var positions []int
+	for i, r := range runes {
+		if isDigit(r) || (r >= 'A' && r <= 'Z') {
+			positions = append(positions, i)
+		}
+	}
+	if len(positions) < 5 {
+		return zeroDigits(s)
+	}
+	var bbanDigits []int
+	for _, i := range positions[4:] {
+		if isDigit(runes[i]) {
+			runes[i] = '0'
+			bbanDigits = append(bbanDigits, i)
+		} else {
+			runes[i] = 'X'
+		}
+	}
+	if len(bbanDigits) < 2 {
+		return string(runes)
+	}
+	tens, ones := bbanDigits[len(bbanDigits)-2], bbanDigits[len(bbanDigits)-1]
+	for n := 0; n < 100; n++ {
+		runes[tens], runes[ones] = rune('0'+n/10), rune('0'+n%10)
+		if ibanValid(runes, positions) {
+			break
+		}
+	}
+	return string(runes)
+}
+
+func ibanValid(runes []rune, positions []int) bool {
+	mod := 0
+	for _, i := range append(append([]int(nil), positions[4:]...), positions[:4]...) {
+		r := runes[i]
+		if isDigit(r) {
+			mod = (mod*10 + int(r-'0')) % 97
+		} else {
+			mod = (mod*100 + int(r-'A') + 10) % 97
+		}
+	}
+	return mod == 1
+}
+
+func luhnValid(runes []rune) bool {
+	sum, double := 0, false
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !isDigit(runes[i]) {
+			continue
+		}
+		d := int(runes[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+// countryCodeLength returns the length of the calling code starting code, see ITU-T E.164
+func countryCodeLength(code string) int {
+	if code == "" {
+		return 0
+	}
+	switch code[0] {
+	case '1', '7':
+		return 1
+	}
+	if len(code) >= 2 && twoDigitCountryCodes[code[:2]] {
+		return 2
+	}
+	return 3
+}
+
+var twoDigitCountryCodes = associateTrue(
+	"20", "27", "30", "31", "32", "33", "34", "36", "39", "40", "41", "43", "44", "45", "46", "47", "48", "49",
+	"51", "52", "53", "54", "55", "56", "57", "58", "60", "61", "62", "63", "64", "65", "66",
+	"81", "82", "84", "86", "90", "91", "92", "93", "94", "95", "98",
+)
+
+func associateTrue(items ...string) map[string]bool {
+	result := make(map[string]bool, len(items))
+	for _, item := range items {
+		result[item] = true
+	}
+	return result
+}
+
+func zeroDigits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if isDigit(r) {
+			return '0'
+		}
+		return r
+	}, s)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
@@ -0,0 +1,88 @@
+package handlers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestFormatPreserving(t *testing.T) {
+	t.Parallel()
+	redactor := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: FormatPreserving(Placeholder("***"))}
+	message := &testproto.Contacts{
+		Card:   "4111 1111 1111 1111",
+		Email:  "john.doe@example.com",
+		Phone:  "+44 20 7946 0958",
+		Iban:   "GB82 WEST 1234 5698 7654 32",
+		Name:   "John Doe",
+		Emails: []string{"jane@example.org", "invalid"},
+	}
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(&testproto.Contacts{
+		Card:   "4111 1100 0009 1111",
+		Email:  "j***@example.com",
+		Phone:  "+44 00 0000 0000",
+		Iban:   "GB82 XXXX 0000 0000 0000 86",
+		Name:   "***",
+		Emails: []string{"j***@example.org", "*******"},
+	}, message), message)
+	assert.True(t, luhnValid([]rune(message.Card)))
+
+	// fields without format go to fallback
+	scalars := &testproto.Scalars{FieldString: "a", FieldInt64: 42}
+	assert.NoError(t, redactor.Redact(scalars))
+	assert.True(t, proto.Equal(&testproto.Scalars{FieldString: "***"}, scalars), scalars)
+}
+
+func TestMaskCard(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in, want string
+	}{
+		{"4111111111111111", "4111110000091111"},
+		{"5500-0000-0000-0004", "5500-0000-0000-0004"},
+		{"12345", "00000"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MaskCard(tt.in))
+	}
+	for _, card := range []string{"378282246310005", "6011 1111 1111 1117", "3530111333300000"} {
+		got := MaskCard(card)
+		assert.Equal(t, card[:6], got[:6])
+		assert.Equal(t, card[len(card)-4:], got[len(got)-4:])
+		assert.True(t, luhnValid([]rune(got)), got)
+	}
+}
+
+func TestMaskPhone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in, want string
+	}{
+		{"+1 (415) 555-2671", "+1 (000) 000-0000"},
+		{"+353 1 234 5678", "+353 0 000 0000"},
+		{"+79161234567", "+70000000000"},
+		{"020 7946 0958", "000 0000 0000"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, MaskPhone(tt.in))
+	}
+}
+
+func TestMaskIBAN(t *testing.T) {
+	t.Parallel()
+	for _, iban := range []string{"GB82 WEST 1234 5698 7654 32", "DE89370400440532013000", "FR1420041010050500013M02606"} {
+		got := MaskIBAN(iban)
+		assert.Equal(t, len(iban), len(got))
+		assert.Equal(t, iban[:4], got[:4])
+		var positions []int
+		for i, r := range []rune(got) {
+			if r != ' ' {
+				positions = append(positions, i)
+			}
+		}
+		assert.True(t, ibanValid([]rune(got), positions), got)
+	}
+}
@@ -0,0 +1,209 @@
+// Package handlers provides ready-made protoredact.Handler implementations.
+//
+// Every handler is safe for lists and maps: it is applied to each element of a sensitive list,
+// each value of a sensitive map and each value of an entry listed in map_keys_to_redact.
+// Values of kinds a handler does not support are cleared, so nothing sensitive is left behind.
+package handlers
+
+import (
+	"errors"
+	"github.com/yonesko/protoredact"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+	"unicode/utf8"
+)
+
+var errUnsupported = errors.New("unsupported kind")
+
+const (
+	// DefaultMaskChar is used by KeepFirstN and KeepLastN
+	DefaultMaskChar = '*'
+	// fixedMaskLength is the length of MaskWithChar output when the original length is hidden
+	fixedMaskLength = 8
+)
+
+// Clear clears the field, map entries and list elements get zero value
+var Clear protoredact.Handler = protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+	c.Clear()
+	return nil
+})
+
+// Zero sets numbers, bools and enums to zero keeping field presence, other kinds are cleared
+var Zero = Scalar(func(kind protoreflect.Kind, v protoreflect.Value) (protoreflect.Value, bool) {
+	switch kind {
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(false), true
+	case protoreflect.EnumKind:
+		return protoreflect.ValueOfEnum(0), true
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(0), true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(0), true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(0), true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(0), true
+	case protoreflect.FloatKind:
+		return protoreflect.ValueOfFloat32(0), true
+	case protoreflect.DoubleKind:
+		return protoreflect.ValueOfFloat64(0), true
+	}
+	return protoreflect.Value{}, false
+})
+
+// Placeholder replaces strings and bytes with placeholder
+func Placeholder(placeholder string) protoredact.Handler {
+	return Text(func(string) string {
+		return placeholder
+	})
+}
+
+// KeepLastN masks all but the last n characters with DefaultMaskChar, values not longer than n are masked entirely,
+// negative n keeps nothing
+func KeepLastN(n int) protoredact.Handler {
+	n = max(n, 0)
+	return Text(func(s string) string {
+		runes := []rune(s)
+		if len(runes) <= n {
+			return strings.Repeat(string(DefaultMaskChar), len(runes))
+		}
+		return strings.Repeat(string(DefaultMaskChar), len(runes)-n) + string(runes[len(runes)-n:])
+	})
+}
+
+// KeepFirstN masks all but the first n characters with DefaultMaskChar, values not longer than n are masked entirely,
+// negative n keeps nothing
+func KeepFirstN(n int) protoredact.Handler {
+	n = max(n, 0)
+	return Text(func(s string) string {
+		runes := []rune(s)
+		if len(runes) <= n {
+			return strings.Repeat(string(DefaultMaskChar), len(runes))
+		}
+		return string(runes[:n]) + strings.Repeat(string(DefaultMaskChar), len(runes)-n)
+	})
+}
+
+// MaskWithChar replaces every character with char, if preserveLength is false the mask has fixed length
+func MaskWithChar(char rune, preserveLength bool) protoredact.Handler {
+	return Text(func(s string) string {
+		if !preserveLength {
+			return strings.Repeat(string(char), fixedMaskLength)
+		}
+		return strings.Repeat(string(char), utf8.RuneCountInString(s))
+	})
+}
+
+// ByKind dispatches by kind of the value, kinds missing in handlers go to fallback
+func ByKind(handlers map[protoreflect.Kind]protoredact.Handler, fallback protoredact.Handler) protoredact.Handler {
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		if h, ok := handlers[valueField(c.Field).Kind()]; ok {
+			return h.Handle(c)
+		}
+		return fallback.Handle(c)
+	})
+}
+
+// Text makes a handler replacing strings and bytes with f result
+func Text(f func(s string) string) protoredact.Handler {
+	return Scalar(func(kind protoreflect.Kind, v protoreflect.Value) (protoreflect.Value, bool) {
+		switch kind {
+		case protoreflect.StringKind:
+			return protoreflect.ValueOfString(f(v.String())), true
+		case protoreflect.BytesKind:
+			return protoreflect.ValueOfBytes([]byte(f(string(v.Bytes())))), true
+		}
+		return protoreflect.Value{}, false
+	})
+}
+
+// Scalar makes a handler replacing every scalar value with f result.
+// Targets f does not support (ok is false) are cleared entirely, lists and maps included.
+func Scalar(f func(kind protoreflect.Kind, v protoreflect.Value) (replacement protoreflect.Value, ok bool)) protoredact.Handler {
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		err := Each(c, func(c protoredact.HandlerContext) error {
+			replacement, ok := f(valueField(c.Field).Kind(), c.Value)
+			if !ok {
+				return errUnsupported
+			}
+			c.Set(replacement)
+			return nil
+		})
+		if err == errUnsupported {
+			c.Clear()
+			return nil
+		}
+		return err
+	})
+}
+
+// Each calls h for the target of c, for whole lists and maps h is called per element.
+// Messages are cleared.
+// Elements are copied to a new list or map first, so h can Set them in RedactClone as well.
+func Each(c protoredact.HandlerContext, h func(c protoredact.HandlerContext) error) error {
+	if valueField(c.Field).Message() != nil {
+		c.Clear()
+		return nil
+	}
+	if c.MapKey.IsValid() || c.ListIndex >= 0 || !(c.Field.IsList() || c.Field.IsMap()) {
+		return h(c)
+	}
+	if c.Field.IsList() {
+		list := c.Parent.NewField(c.Field).List()
+		for i := 0; i < c.Value.List().Len(); i++ {
+			list.Append(copyScalar(c.Value.List().Get(i)))
+		}
+		c.Set(protoreflect.ValueOfList(list))
+		for i := 0; i < list.Len(); i++ {
+			elem := c
+			elem.ListIndex = i
+			elem.Value = list.Get(i)
+			elem.Values = appendStep(c.Values, protopath.ListIndex(i), elem.Value)
+			if err := h(elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	m := c.Parent.NewField(c.Field).Map()
+	var keys []protoreflect.MapKey
+	c.Value.Map().Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		m.Set(key, copyScalar(value))
+		keys = append(keys, key)
+		return true
+	})
+	c.Set(protoreflect.ValueOfMap(m))
+	for _, key := range keys {
+		entry := c
+		entry.MapKey = key
+		entry.Value = m.Get(key)
+		entry.Values = appendStep(c.Values, protopath.MapIndex(key), entry.Value)
+		if err := h(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// valueField returns the descriptor describing single values of fd: map values for maps, fd otherwise
+func valueField(fd protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	if fd.IsMap() {
+		return fd.MapValue()
+	}
+	return fd
+}
+
+func appendStep(values protopath.Values, step protopath.Step, v protoreflect.Value) protopath.Values {
+	return protopath.Values{
+		Path:   append(append(protopath.Path(nil), values.Path...), step),
+		Values: append(append([]protoreflect.Value(nil), values.Values...), v),
+	}
+}
+
+func copyScalar(v protoreflect.Value) protoreflect.Value {
+	if b, ok := v.Interface().([]byte); ok {
+		return protoreflect.ValueOfBytes(append([]byte(nil), b...))
+	}
+	return v
+}
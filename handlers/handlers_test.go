@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"testing"
+)
+
+func newScalars() *testproto.Scalars {
+	return &testproto.Scalars{
+		FieldString:      "4111111111111111",
+		FieldBytes:       []byte("secret"),
+		FieldInt64:       42,
+		FieldUint32:      7,
+		FieldDouble:      3.14,
+		FieldBool:        true,
+		Enum1:            testproto.Enum1_ENUM_1_VAL_1,
+		StringList:       []string{"alpha", "be"},
+		StringMap:        map[string]string{"k": "value"},
+		Headers:          map[string]string{"authorization": "Bearer token", "accept": "*/*"},
+		FieldStringPlain: "plain",
+	}
+}
+
+func TestHandlers(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name    string
+		handler protoredact.Handler
+		want    *testproto.Scalars
+	}{
+		{
+			name:    "clear",
+			handler: Clear,
+			want:    &testproto.Scalars{Headers: map[string]string{"authorization": "", "accept": "*/*"}, FieldStringPlain: "plain"},
+		},
+		{
+			name:    "zero",
+			handler: Zero,
+			want:    &testproto.Scalars{Headers: map[string]string{"authorization": "", "accept": "*/*"}, FieldStringPlain: "plain"},
+		},
+		{
+			name:    "placeholder",
+			handler: Placeholder("***"),
+			want: &testproto.Scalars{
+				FieldString:      "***",
+				FieldBytes:       []byte("***"),
+				StringList:       []string{"***", "***"},
+				StringMap:        map[string]string{"k": "***"},
+				Headers:          map[string]string{"authorization": "***", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+		{
+			name:    "keep last 4",
+			handler: KeepLastN(4),
+			want: &testproto.Scalars{
+				FieldString:      "************1111",
+				FieldBytes:       []byte("**cret"),
+				StringList:       []string{"*lpha", "**"},
+				StringMap:        map[string]string{"k": "*alue"},
+				Headers:          map[string]string{"authorization": "********oken", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+		{
+			name:    "keep first 2",
+			handler: KeepFirstN(2),
+			want: &testproto.Scalars{
+				FieldString:      "41**************",
+				FieldBytes:       []byte("se****"),
+				StringList:       []string{"al***", "**"},
+				StringMap:        map[string]string{"k": "va***"},
+				Headers:          map[string]string{"authorization": "Be**********", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+		{
+			name:    "keep first negative",
+			handler: KeepFirstN(-1),
+			want: &testproto.Scalars{
+				FieldString:      "****************",
+				FieldBytes:       []byte("******"),
+				StringList:       []string{"*****", "**"},
+				StringMap:        map[string]string{"k": "*****"},
+				Headers:          map[string]string{"authorization": "************", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+		{
+			name:    "keep last negative",
+			handler: KeepLastN(-1),
+			want: &testproto.Scalars{
+				FieldString:      "****************",
+				FieldBytes:       []byte("******"),
+				StringList:       []string{"*****", "**"},
+				StringMap:        map[string]string{"k": "*****"},
+				Headers:          map[string]string{"authorization": "************", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+		{
+			name:    "mask preserving length",
+			handler: MaskWithChar('#', true),
+			want: &testproto.Scalars{
+				FieldString:      "################",
+				FieldBytes:       []byte("######"),
+				StringList:       []string{"#####", "##"},
+				StringMap:        map[string]string{"k": "#####"},
+				Headers:          map[string]string{"authorization": "############", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+		{
+			name:    "mask hiding length",
+			handler: MaskWithChar('x', false),
+			want: &testproto.Scalars{
+				FieldString:      "xxxxxxxx",
+				FieldBytes:       []byte("xxxxxxxx"),
+				StringList:       []string{"xxxxxxxx", "xxxxxxxx"},
+				StringMap:        map[string]string{"k": "xxxxxxxx"},
+				Headers:          map[string]string{"authorization": "xxxxxxxx", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+		{
+			name: "by kind",
+			handler: ByKind(map[protoreflect.Kind]protoredact.Handler{
+				protoreflect.StringKind: Placeholder("REDACTED"),
+				protoreflect.Int64Kind:  Zero,
+			}, Clear),
+			want: &testproto.Scalars{
+				FieldString:      "REDACTED",
+				StringList:       []string{"REDACTED", "REDACTED"},
+				StringMap:        map[string]string{"k": "REDACTED"},
+				Headers:          map[string]string{"authorization": "REDACTED", "accept": "*/*"},
+				FieldStringPlain: "plain",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			redactor := protoredact.Redactor{
+				SensitiveFieldAnnotation: testproto.E_SensitiveData,
+				Handler:                  tt.handler,
+				MapEntryHandler:          protoredact.ApplyToMapValue(tt.handler),
+			}
+			message := newScalars()
+
+			cloned, err := redactor.RedactClone(message)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(tt.want, cloned), cloned)
+			assert.True(t, proto.Equal(newScalars(), message))
+
+			assert.NoError(t, redactor.Redact(message))
+			assert.True(t, proto.Equal(tt.want, message), message)
+		})
+	}
+}
+
+func TestHandlers_Messages(t *testing.T) {
+	t.Parallel()
+	redactor := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: KeepLastN(4)}
+	message := &testproto.WithAllFieldTypes{
+		FieldStringSensitive: "progress",
+		MessageListSensitive: []*testproto.WithAllFieldTypes_Internal{{FieldInt64: 915}},
+		Enum1Sensitive:       testproto.Enum1_ENUM_1_VAL_1,
+	}
+
+	assert.NoError(t, redactor.Redact(message))
+
+	assert.True(t, proto.Equal(&testproto.WithAllFieldTypes{FieldStringSensitive: "****ress"}, message), message)
+}
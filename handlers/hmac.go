@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"github.com/yonesko/protoredact"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strconv"
+)
+
+// HMAC pseudonymizes values with HMAC-SHA256 digest under Key, the same input always gives the same output.
+// Strings get the encoded digest, bytes get the raw digest, integers get a digest-derived integer of the same kind,
+// other kinds are cleared.
+// Integers are hashed in decimal form, so 42 and "42" give the same digest.
+type HMAC struct {
+	Key []byte
+	// Encode encodes digests written to strings, hex by default
+	Encode func(digest []byte) string
+	// Length truncates strings to Length characters and bytes to Length bytes, 0 keeps the whole digest
+	Length int
+}
+
+func (h HMAC) Handle(c protoredact.HandlerContext) error {
+	if len(h.Key) == 0 {
+		return errors.New("handlers: HMAC key is empty")
+	}
+	return Scalar(h.replace).Handle(c)
+}
+
+func (h HMAC) replace(kind protoreflect.Kind, v protoreflect.Value) (protoreflect.Value, bool) {
+	switch kind {
+	case protoreflect.StringKind:
+		encode := h.Encode
+		if encode == nil {
+			encode = hex.EncodeToString
+		}
+		s := encode(h.digest([]byte(v.String())))
+		if h.Length > 0 && h.Length < len(s) {
+			s = s[:h.Length]
+		}
+		return protoreflect.ValueOfString(s), true
+	case protoreflect.BytesKind:
+		b := h.digest(v.Bytes())
+		if h.Length > 0 && h.Length < len(b) {
+			b = b[:h.Length]
+		}
+		return protoreflect.ValueOfBytes(b), true
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return protoreflect.ValueOfInt32(int32(h.number(strconv.FormatInt(v.Int(), 10)))), true
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(int64(h.number(strconv.FormatInt(v.Int(), 10)))), true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return protoreflect.ValueOfUint32(uint32(h.number(strconv.FormatUint(v.Uint(), 10)))), true
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(h.number(strconv.FormatUint(v.Uint(), 10))), true
+	}
+	return protoreflect.Value{}, false
+}
+
+func (h HMAC) digest(b []byte) []byte {
+	mac := hmac.New(sha256.New, h.Key)
+	mac.Write(b)
+	return mac.Sum(nil)
+}
+
+func (h HMAC) number(decimal string) uint64 {
+	return binary.BigEndian.Uint64(h.digest([]byte(decimal)))
+}
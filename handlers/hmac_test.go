@@ -0,0 +1,73 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"strconv"
+	"testing"
+)
+
+func TestHMAC(t *testing.T) {
+	t.Parallel()
+	redact := func(h HMAC, message *testproto.Scalars) *testproto.Scalars {
+		redactor := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: h}
+		assert.NoError(t, redactor.Redact(message))
+		return message
+	}
+	key := []byte("key")
+
+	got := redact(HMAC{Key: key}, &testproto.Scalars{
+		FieldString: "The quick brown fox jumps over the lazy dog",
+		StringList:  []string{"42", "The quick brown fox jumps over the lazy dog"},
+		FieldInt64:  42,
+		FieldBool:   true,
+	})
+	assert.Equal(t, "f7bc83f430538424b13298e6aa6fb143ef4d59a14946175997479dbc2d1a3cd8", got.FieldString)
+	assert.Equal(t, got.FieldString, got.StringList[1])
+	// integers are derived from the digest of their decimal form
+	assert.Equal(t, int64(must(strconv.ParseUint(got.StringList[0][:16], 16, 64))), got.FieldInt64)
+	assert.False(t, got.FieldBool)
+
+	again := redact(HMAC{Key: key}, &testproto.Scalars{FieldInt64: 42, FieldUint32: 42})
+	assert.Equal(t, got.FieldInt64, again.FieldInt64)
+	assert.Equal(t, uint32(uint64(got.FieldInt64)), again.FieldUint32)
+
+	other := redact(HMAC{Key: []byte("other")}, &testproto.Scalars{FieldString: "The quick brown fox jumps over the lazy dog"})
+	assert.NotEqual(t, got.FieldString, other.FieldString)
+
+	truncated := redact(HMAC{Key: key, Encode: base64.RawURLEncoding.EncodeToString, Length: 10}, &testproto.Scalars{
+		FieldString: "The quick brown fox jumps over the lazy dog",
+		FieldBytes:  []byte("The quick brown fox jumps over the lazy dog"),
+	})
+	assert.Equal(t, "97yD9DBThC", truncated.FieldString)
+	assert.Equal(t, []byte{0xf7, 0xbc, 0x83, 0xf4, 0x30, 0x53, 0x84, 0x24, 0xb1, 0x32}, truncated.FieldBytes)
+
+	redactor := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: HMAC{}}
+	assert.EqualError(t, redactor.Redact(&testproto.Scalars{FieldString: "a"}), "handlers: HMAC key is empty")
+}
+
+func TestHMAC_MapKeys(t *testing.T) {
+	t.Parallel()
+	h := HMAC{Key: []byte("key"), Length: 8}
+	redactor := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: Clear, MapKeyHandler: h}
+	message := &testproto.UserStats{
+		PerContact: map[string]int64{"bob@example.com": 1, "local": 3},
+		PerPhone:   map[int64]*testproto.Plain{79001234567: {FieldInt64: 1}},
+	}
+	assert.NoError(t, redactor.Redact(message))
+
+	// keys get the same pseudonyms as values
+	pseudonyms := &testproto.Scalars{FieldString: "bob@example.com", FieldInt64: 79001234567}
+	assert.NoError(t, protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: h}.Redact(pseudonyms))
+	assert.Equal(t, map[string]int64{pseudonyms.FieldString: 1, "local": 3}, message.PerContact)
+	assert.Equal(t, int64(1), message.PerPhone[pseudonyms.FieldInt64].GetFieldInt64())
+}
+
+func must[T any](val T, err error) T {
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
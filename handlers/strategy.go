@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"fmt"
+	"github.com/yonesko/protoredact"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// defaultPlaceholder is used by PLACEHOLDER strategy when the annotation has no placeholder
+const defaultPlaceholder = "***"
+
+// Strategies dispatches to a built-in handler chosen by the `strategy` enum of the annotation,
+// so proto authors declare the treatment next to the field definition:
+//
+//	CLEAR        - Clear
+//	MASK         - MaskWithChar, keeps the length if `preserve_length` is set
+//	HASH         - Hash
+//	PLACEHOLDER  - Placeholder with `placeholder`, "***" if empty
+//	KEEP_LAST_N  - KeepLastN with `keep_last_n`
+//
+// Enum values are matched ignoring the enum prefix, so STRATEGY_MASK and MASK are the same.
+type Strategies struct {
+	// Hash handles HASH, usually HMAC, fields with HASH fail if it is not set
+	Hash protoredact.Handler
+	// Fallback handles fields without strategy, Clear by default
+	Fallback protoredact.Handler
+}
+
+// Default returns protoredact.Default dispatching on the annotation as proto authors declared it:
+// fields and map entries with `strategy` go to Strategies, with `format` to FormatPreserving, others are cleared.
+// hash handles HASH, usually HMAC, fields with HASH fail if it is nil.
+// It is experimental as protoredact.Default is
+func Default(hash protoredact.Handler) protoredact.Redactor {
+	r := protoredact.Default
+	r.RedactingHandler = nil
+	r.Handler = Strategies{Hash: hash, Fallback: FormatPreserving(Clear)}
+	r.MapEntryHandler = protoredact.ApplyToMapValue(r.Handler)
+	return r
+}
+
+func (s Strategies) Handle(c protoredact.HandlerContext) error {
+	switch enumChoice(annotationEnum(c.Annotation, "strategy"), "CLEAR", "MASK", "HASH", "PLACEHOLDER", "KEEP_LAST_N") {
+	case "CLEAR":
+		return Clear.Handle(c)
+	case "MASK":
+		preserveLength, ok := annotationField(c.Annotation, "preserve_length", protoreflect.BoolKind)
+		return MaskWithChar(DefaultMaskChar, ok && preserveLength.Bool()).Handle(c)
+	case "HASH":
+		if s.Hash == nil {
+			return fmt.Errorf("handlers: %s uses HASH strategy, but Strategies.Hash is not set", c.Field.FullName())
+		}
+		return s.Hash.Handle(c)
+	case "PLACEHOLDER":
+		placeholder := defaultPlaceholder
+		if v, ok := annotationField(c.Annotation, "placeholder", protoreflect.StringKind); ok && v.String() != "" {
+			placeholder = v.String()
+		}
+		return Placeholder(placeholder).Handle(c)
+	case "KEEP_LAST_N":
+		n := 0
+		if v, ok := annotationField(c.Annotation, "keep_last_n", protoreflect.Uint32Kind); ok {
+			n = int(v.Uint())
+		}
+		return KeepLastN(n).Handle(c)
+	}
+	if s.Fallback != nil {
+		return s.Fallback.Handle(c)
+	}
+	return Clear.Handle(c)
+}
@@ -0,0 +1,71 @@
+package handlers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestStrategies(t *testing.T) {
+	t.Parallel()
+	newMessage := func() *testproto.Treatments {
+		return &testproto.Treatments{
+			Cleared:     "secret",
+			Masked:      "secret",
+			Hashed:      "The quick brown fox jumps over the lazy dog",
+			Placeholder: "4111111111111111",
+			LastFour:    "4111111111111111",
+			Unspecified: "secret",
+			MaskedList:  []string{"a", "secret"},
+			HashedInt64: 42,
+		}
+	}
+	hash := HMAC{Key: []byte("key")}
+	redactor := protoredact.Redactor{
+		SensitiveFieldAnnotation: testproto.E_SensitiveData,
+		Handler:                  Strategies{Hash: hash, Fallback: Placeholder("fallback")},
+	}
+	message := newMessage()
+	assert.NoError(t, redactor.Redact(message))
+
+	hashed := &testproto.Scalars{FieldString: "The quick brown fox jumps over the lazy dog", FieldInt64: 42}
+	assert.NoError(t, protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: hash}.Redact(hashed))
+	assert.True(t, proto.Equal(&testproto.Treatments{
+		Masked:      "******",
+		Hashed:      hashed.FieldString,
+		Placeholder: "<card>",
+		LastFour:    "************1111",
+		Unspecified: "fallback",
+		MaskedList:  []string{"********", "********"},
+		HashedInt64: hashed.FieldInt64,
+	}, message), message)
+
+	// fields without strategy are cleared by default
+	scalars := &testproto.Scalars{FieldString: "secret", FieldStringPlain: "plain"}
+	assert.NoError(t, protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: Strategies{}}.Redact(scalars))
+	assert.True(t, proto.Equal(&testproto.Scalars{FieldStringPlain: "plain"}, scalars), scalars)
+
+	redactor.Handler = Strategies{}
+	assert.EqualError(t, redactor.Redact(newMessage()),
+		"handlers: testproto.Treatments.hashed uses HASH strategy, but Strategies.Hash is not set")
+}
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+	message := &testproto.Canonical{
+		FieldStringSensitive: "secret",
+		Headers:              map[string]string{"authorization": "Bearer token", "accept": "*/*"},
+		FieldString:          "plain",
+		Masked:               "secret",
+		Email:                "john@example.com",
+	}
+	assert.NoError(t, Default(nil).Redact(message))
+	assert.True(t, proto.Equal(&testproto.Canonical{
+		Headers:     map[string]string{"authorization": "", "accept": "*/*"},
+		FieldString: "plain",
+		Masked:      "******",
+		Email:       "j***@example.com",
+	}, message), message)
+}
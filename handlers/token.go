@@ -0,0 +1,203 @@
+package handlers
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/yonesko/protoredact"
+	"google.golang.org/protobuf/proto"
+	"os"
+	"strings"
+	"sync"
+)
+
+// tokenPrefix marks values replaced by Tokenize
+const tokenPrefix = "tok_"
+
+// TokenStore keeps the mapping between sensitive values and opaque tokens
+type TokenStore interface {
+	// Token returns the token of value, the same value always gets the same token
+	Token(value []byte) (string, error)
+	// Value returns the original value of token, ok is false for unknown tokens
+	Value(token string) (value []byte, ok bool, err error)
+}
+
+// Tokenize replaces strings and bytes with random tokens kept in store.
+// Other kinds cannot hold the token, Tokenize fails on them instead of losing data.
+func Tokenize(store TokenStore) protoredact.Handler {
+	return protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		if err := checkText("Tokenize", c.Field); err != nil {
+			return err
+		}
+		return Each(c, func(c protoredact.HandlerContext) error {
+			token, err := store.Token(textBytes(c.Value))
+			if err != nil {
+				return err
+			}
+			c.Set(textValue(c.Field, token))
+			return nil
+		})
+	})
+}
+
+// Tokenizing returns r tokenizing sensitive values, map entries listed in map_keys_to_redact
+// and keys of redact_map_keys maps, so Detokenizer can put all of them back.
+// Sensitive enum values are still replaced by EnumValueHandler and cannot be put back
+func Tokenizing(r protoredact.Redactor, store TokenStore) protoredact.Redactor {
+	r.RedactingHandler = nil
+	r.Handler = Tokenize(store)
+	r.MapEntryHandler = protoredact.ApplyToMapValue(r.Handler)
+	r.MapKeyHandler = r.Handler
+	return r
+}
+
+// Detokenizer puts back values replaced by Tokenizing in the fields Redactor selects.
+// Handlers of Redactor are replaced, map entries listed in map_keys_to_redact are restored with ApplyToMapValue
+// and fail if they were cleared instead of tokenized,
+// tokenized keys of redact_map_keys maps are restored, other keys and enum values are kept.
+type Detokenizer struct {
+	Redactor protoredact.Redactor
+	Store    TokenStore
+}
+
+func (d Detokenizer) Detokenize(msg proto.Message) error {
+	r := d.Redactor
+	r.RedactingHandler = nil
+	r.Handler = protoredact.HandlerFunc(func(c protoredact.HandlerContext) error {
+		if err := checkText("Detokenize", c.Field); err != nil {
+			return err
+		}
+		return Each(c, func(c protoredact.HandlerContext) error {
+			token := string(textBytes(c.Value))
+			if token == "" {
+				return nil
+			}
+			value, ok, err := d.Store.Value(token)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return fmt.Errorf("handlers: %s holds unknown token", c.Values.Path)
+			}
+			c.Set(textValue(c.Field, string(value)))
+			return nil
+		})
+	})
+	r.MapEntryHandler = restoreEntries("Tokenizing", r.Handler)
+	r = r.RestoringMapKeys(restoreKeys(r.Handler, func(key string) bool {
+		return strings.HasPrefix(key, tokenPrefix)
+	}))
+	r.EnumValueHandler = keep
+	return r.Redact(msg)
+}
+
+// MemoryTokenStore is a TokenStore living in memory, the zero value is ready to use
+type MemoryTokenStore struct {
+	mu       sync.RWMutex
+	tokens   map[string]string
+	values   map[string][]byte
+	onCreate func(token string, value []byte) error
+}
+
+func (s *MemoryTokenStore) Token(value []byte) (string, error) {
+	s.mu.RLock()
+	token, ok := s.tokens[string(value)]
+	s.mu.RUnlock()
+	if ok {
+		return token, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if token, ok := s.tokens[string(value)]; ok {
+		return token, nil
+	}
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+	if s.onCreate != nil {
+		if err := s.onCreate(token, value); err != nil {
+			return "", err
+		}
+	}
+	s.put(token, value)
+	return token, nil
+}
+
+func (s *MemoryTokenStore) Value(token string) ([]byte, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	value, ok := s.values[token]
+	return append([]byte(nil), value...), ok, nil
+}
+
+func (s *MemoryTokenStore) put(token string, value []byte) {
+	if s.tokens == nil {
+		s.tokens = map[string]string{}
+		s.values = map[string][]byte{}
+	}
+	s.tokens[string(value)] = token
+	s.values[token] = append([]byte(nil), value...)
+}
+
+// FileTokenStore is a TokenStore persisting new tokens to a JSON lines file
+type FileTokenStore struct {
+	MemoryTokenStore
+	file *os.File
+}
+
+type tokenRecord struct {
+	Token string `json:"token"`
+	Value []byte `json:"value"`
+}
+
+// OpenFileTokenStore loads tokens from path creating the file if needed
+func OpenFileTokenStore(path string) (*FileTokenStore, error) {
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	s := &FileTokenStore{file: file}
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(nil, 1<<24)
+	for scanner.Scan() {
+		var record tokenRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("handlers: token store %s: %w", path, err)
+		}
+		s.put(record.Token, record.Value)
+	}
+	if err := scanner.Err(); err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	s.onCreate = s.append
+	return s, nil
+}
+
+func (s *FileTokenStore) append(token string, value []byte) error {
+	line, err := json.Marshal(tokenRecord{Token: token, Value: value})
+	if err != nil {
+		return err
+	}
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	return s.file.Sync()
+}
+
+func (s *FileTokenStore) Close() error {
+	return s.file.Close()
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return tokenPrefix + hex.EncodeToString(b), nil
+}
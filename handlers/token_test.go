@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/proto"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	t.Parallel()
+	newMessage := func() *testproto.Scalars {
+		return &testproto.Scalars{
+			FieldString:      "alpha",
+			FieldBytes:       []byte("secret"),
+			StringList:       []string{"alpha", "be"},
+			StringMap:        map[string]string{"k": "value"},
+			Headers:          map[string]string{"authorization": "Bearer token", "accept": "*/*"},
+			FieldStringPlain: "plain",
+		}
+	}
+	sensitive := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData}
+	memory := &MemoryTokenStore{}
+	file, err := OpenFileTokenStore(filepath.Join(t.TempDir(), "tokens.jsonl"))
+	assert.NoError(t, err)
+	defer file.Close()
+
+	for name, store := range map[string]TokenStore{"memory": memory, "file": file} {
+		t.Run(name, func(t *testing.T) {
+			redactor := Tokenizing(sensitive, store)
+			message := newMessage()
+
+			assert.NoError(t, redactor.Redact(message))
+
+			assert.True(t, strings.HasPrefix(message.FieldString, tokenPrefix))
+			assert.Equal(t, message.FieldString, message.StringList[0], "duplicates collapse")
+			assert.NotEqual(t, message.StringList[0], message.StringList[1])
+			assert.True(t, strings.HasPrefix(message.Headers["authorization"], tokenPrefix))
+			assert.Equal(t, "*/*", message.Headers["accept"])
+			assert.NotContains(t, message.String(), "secret")
+
+			again := newMessage()
+			assert.NoError(t, redactor.Redact(again))
+			assert.True(t, proto.Equal(message, again), "tokens are stable within a store")
+
+			assert.NoError(t, Detokenizer{Redactor: sensitive, Store: store}.Detokenize(message))
+			assert.True(t, proto.Equal(newMessage(), message), message)
+		})
+	}
+
+	assert.EqualError(t, Detokenizer{Redactor: sensitive, Store: &MemoryTokenStore{}}.Detokenize(&testproto.Scalars{FieldString: "tok_unknown"}),
+		"handlers: (testproto.Scalars).fieldString holds unknown token")
+	redactor := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData, Handler: Tokenize(memory)}
+	assert.EqualError(t, redactor.Redact(&testproto.Scalars{FieldInt64: 42}),
+		"handlers: Tokenize supports only strings and bytes, testproto.Scalars.fieldInt64 is int64")
+
+	// entries zeroed by the default MapEntryHandler are reported
+	zeroed := newMessage()
+	assert.NoError(t, redactor.Redact(zeroed))
+	assert.EqualError(t, Detokenizer{Redactor: sensitive, Store: memory}.Detokenize(zeroed),
+		`handlers: (testproto.Scalars).headers["authorization"] is empty and cannot be restored, redact with Tokenizing`)
+}
+
+func TestFileTokenStore_Reopen(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "tokens.jsonl")
+	store, err := OpenFileTokenStore(path)
+	assert.NoError(t, err)
+	token, err := store.Token([]byte("alpha"))
+	assert.NoError(t, err)
+	assert.NoError(t, store.Close())
+
+	reopened, err := OpenFileTokenStore(path)
+	assert.NoError(t, err)
+	defer reopened.Close()
+	value, ok, err := reopened.Value(token)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("alpha"), value)
+	sameToken, err := reopened.Token([]byte("alpha"))
+	assert.NoError(t, err)
+	assert.Equal(t, token, sameToken)
+}
+
+func TestDetokenize_MapKeys(t *testing.T) {
+	t.Parallel()
+	newStats := func() *testproto.UserStats {
+		return &testproto.UserStats{
+			PerUser:    map[string]*testproto.Plain{"bob@example.com": {FieldString: "secret", FieldInt64: 1}},
+			PerContact: map[string]int64{"bob@example.com": 1, "local": 2},
+		}
+	}
+	store := &MemoryTokenStore{}
+	sensitive := protoredact.Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData}
+	redactor := Tokenizing(sensitive, store)
+
+	message := newStats()
+	assert.NoError(t, redactor.Redact(message))
+	assert.NotContains(t, message.String(), "bob@example.com")
+	assert.Equal(t, int64(2), message.PerContact["local"])
+	assert.NoError(t, Detokenizer{Redactor: sensitive, Store: store}.Detokenize(message))
+	assert.True(t, proto.Equal(newStats(), message), message)
+
+	// keys which cannot hold tokens are kept
+	redactor.MapKeyHandler = HMAC{Key: []byte("key")}
+	phones := &testproto.UserStats{PerPhone: map[int64]*testproto.Plain{79001234567: {FieldInt64: 1}}}
+	assert.NoError(t, redactor.Redact(phones))
+	hashed := proto.Clone(phones)
+	assert.NoError(t, Detokenizer{Redactor: sensitive, Store: store}.Detokenize(phones))
+	assert.True(t, proto.Equal(hashed, phones), phones)
+}
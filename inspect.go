@@ -0,0 +1,95 @@
+package protoredact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Finding is a populated sensitive location of a message
+type Finding struct {
+	Path protopath.Path
+	// Field is the annotated field
+	Field protoreflect.FieldDescriptor
+	// MapKey is valid when only this entry of the Field map is sensitive
+	MapKey protoreflect.MapKey
+	// Key means MapKey itself is sensitive, see redact_map_keys
+	Key bool
+	// allKeys means every key of the map is rewritten, so a rewritten key cannot be told from an original one
+	allKeys bool
+	// Annotation is the value of the annotation making the location sensitive, see HandlerContext.Annotation
+	Annotation proto.Message
+}
+
+// Inspect reports what Redact would redact in msg without touching it.
+// Findings are in a stable order: fields in declaration order, map entries by key.
+func (r Redactor) Inspect(msg proto.Message) ([]Finding, error) {
+	if r.annotations().empty() || msg == nil {
+		return nil, nil
+	}
+	m := msg.ProtoReflect()
+	if !m.IsValid() {
+		return nil, nil
+	}
+	var findings []Finding
+	err := walker{redactor: r, stable: true, visit: func(loc location) error {
+		for _, key := range loc.keys {
+			findings = append(findings, Finding{
+				Path:       append(append(protopath.Path(nil), loc.path.Path...), protopath.MapIndex(key)),
+				Field:      loc.field,
+				MapKey:     key,
+				Key:        true,
+				allKeys:    !loc.plan.selectsKeys(),
+				Annotation: loc.annotation(),
+			})
+		}
+		if len(loc.keys) > 0 {
+			return nil
+		}
+		if loc.key.IsValid() && !loc.enumValue && isZero(loc.path.Index(-1).Value) {
+			return nil
+		}
+		findings = append(findings, Finding{
+			Path:       append(protopath.Path(nil), loc.path.Path...),
+			Field:      loc.field,
+			MapKey:     loc.key,
+			Annotation: loc.annotation(),
+		})
+		return nil
+	}}.message(rootPath(m), m)
+	return findings, err
+}
+
+// isZero reports whether v is the zero value of a map entry, which is what Redact leaves for redacted keys
+func isZero(v protoreflect.Value) bool {
+	switch x := v.Interface().(type) {
+	case protoreflect.Message:
+		populated := false
+		x.Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+			populated = true
+			return false
+		})
+		return !populated && len(x.GetUnknown()) == 0
+	case []byte:
+		return len(x) == 0
+	case string:
+		return x == ""
+	case bool:
+		return !x
+	case protoreflect.EnumNumber:
+		return x == 0
+	case int32:
+		return x == 0
+	case int64:
+		return x == 0
+	case uint32:
+		return x == 0
+	case uint64:
+		return x == 0
+	case float32:
+		return x == 0
+	case float64:
+		return x == 0
+	}
+	return false
+}
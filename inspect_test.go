@@ -0,0 +1,54 @@
+package protoredact
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+func TestRedactor_Inspect(t *testing.T) {
+	t.Parallel()
+	redactor := Redactor{SensitiveFieldAnnotation: testproto.E_SensitiveData}
+	message := &testproto.WithAllFieldTypes{
+		PaymentToken:         &testproto.WithAllFieldTypes_Cryptogram{Cryptogram: "earnest"},
+		FieldInt64:           418,
+		FieldStringSensitive: "pad",
+		MessageListSensitive: []*testproto.WithAllFieldTypes_Internal{{FieldInt64: 915}},
+		MessageList: []*testproto.WithAllFieldTypes_Internal{
+			{FieldInt64: 145},
+			{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+				"detail":        {FieldStringSensitive: "Conubiafeugiat"},
+				"hide_this_key": {FieldStringSensitive: "not reported twice"},
+			}},
+			{MapWithSensitiveKeyIntKey: map[int64]*testproto.WithAllFieldTypes_Internal{87654: {}, 642: {FieldInt64: 651}}},
+			{Recursive: &testproto.WithAllFieldTypes_Internal{RecursiveSensitive: &testproto.WithAllFieldTypes_Internal{FieldInt64: 732}}},
+		},
+	}
+	original := proto.Clone(message)
+
+	findings, err := redactor.Inspect(message)
+
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(original, message))
+	type finding struct {
+		path, field, key string
+	}
+	var got []finding
+	for _, f := range findings {
+		var key string
+		if f.MapKey.IsValid() {
+			key = f.MapKey.String()
+		}
+		assert.IsType(t, &testproto.SensitiveData{}, f.Annotation)
+		got = append(got, finding{path: f.Path.String(), field: string(f.Field.Name()), key: key})
+	}
+	assert.Equal(t, []finding{
+		{path: "(testproto.WithAllFieldTypes).cryptogram", field: "cryptogram"},
+		{path: "(testproto.WithAllFieldTypes).fieldStringSensitive", field: "fieldStringSensitive"},
+		{path: "(testproto.WithAllFieldTypes).messageList[1].mapWithSensitiveKey[\"hide_this_key\"]", field: "mapWithSensitiveKey", key: "hide_this_key"},
+		{path: "(testproto.WithAllFieldTypes).messageList[1].mapWithSensitiveKey[\"detail\"].fieldStringSensitive", field: "fieldStringSensitive"},
+		{path: "(testproto.WithAllFieldTypes).messageList[3].recursive.recursiveSensitive", field: "recursiveSensitive"},
+		{path: "(testproto.WithAllFieldTypes).messageListSensitive", field: "messageListSensitive"},
+	}, got)
+}
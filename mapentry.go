@@ -0,0 +1,292 @@
+package protoredact
+
+import (
+	"fmt"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"path"
+	"regexp"
+	"strings"
+)
+
+/*
+compileMap compiles an annotated map field:
+map_keys_to_redact selects entries, keys with *, ? or [ are glob patterns, map_key_regexps are compiled once here
+and map_keys_case_insensitive lowers keys and patterns;
+map_value_fields selects sub-fields of message values to redact instead of whole entries, all entries if no keys are listed;
+redact_map_keys rewrites keys of the selected entries, all entries if no keys are listed, and keeps their values.
+If neither is set, the whole field is hidden.
+Paths of map_value_fields which cannot be resolved make the rule fall back to hiding whole selected entries,
+or the whole field with its keys when no keys are listed; invalid regexps hide the whole field, so a typo does not leak data
+*/
+func compileMap(fd protoreflect.FieldDescriptor, annotation proto.Message, a annotations) fieldPlan {
+	keys, ok := annotationStrings(annotation, "map_keys_to_redact")
+	if !ok {
+		// options which cannot be parsed and annotations of other types carry no map rule, so they fail closed
+		return fieldPlan{annotation: annotation, sensitive: true, reason: reasonAnnotated}
+	}
+	fp := fieldPlan{
+		annotation:        annotation,
+		foldKeys:          annotationBool(annotation, "map_keys_case_insensitive"),
+		redactKeys:        annotationBool(annotation, "redact_map_keys"),
+		keyCollisionError: annotationBool(annotation, "error_on_key_collision"),
+	}
+	for i := range keys {
+		if fp.foldKeys {
+			keys[i] = strings.ToLower(keys[i])
+		}
+		// patterns match literally too, keys such as "items[0]" were listed as exact keys before globs were supported
+		if isKeyPattern(keys[i]) {
+			fp.keyPatterns = append(fp.keyPatterns, keys[i])
+		}
+	}
+	if len(keys) > 0 {
+		fp.keysToHide = associate(keys, func(item string) (string, bool) {
+			return item, true
+		})
+	}
+	exprs, _ := annotationStrings(annotation, "map_key_regexps")
+	for _, expr := range exprs {
+		if fp.foldKeys {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return fieldPlan{annotation: annotation, sensitive: true, reason: reasonAnnotated}
+		}
+		fp.keyRegexps = append(fp.keyRegexps, re)
+	}
+	fp.valueFields, fp.valuesOnly = mapValueFields(fd, annotation, a)
+	if !fp.byEntry() {
+		return fieldPlan{annotation: annotation, sensitive: true, reason: reasonAnnotated}
+	}
+	return fp
+}
+
+// byEntry means the map is redacted entry by entry
+func (fp fieldPlan) byEntry() bool {
+	return fp.selectsKeys() || fp.valuesOnly || fp.redactKeys
+}
+
+func (fp fieldPlan) selectsKeys() bool {
+	return len(fp.keysToHide) > 0 || len(fp.keyPatterns) > 0 || len(fp.keyRegexps) > 0
+}
+
+// matchKey reports whether the entry with key is selected by the map rule
+func (fp fieldPlan) matchKey(key string) bool {
+	if !fp.selectsKeys() {
+		return fp.valuesOnly || fp.redactKeys
+	}
+	for _, re := range fp.keyRegexps {
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	if fp.foldKeys {
+		key = strings.ToLower(key)
+	}
+	if fp.keysToHide[key] {
+		return true
+	}
+	for _, pattern := range fp.keyPatterns {
+		if ok, _ := path.Match(pattern, key); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// matchValue reports whether the value of the entry with key is redacted as a whole or by map_value_fields.
+// Rewritten keys do not match the rule any more, so with redact_map_keys map_value_fields apply to all entries
+func (fp fieldPlan) matchValue(key string) bool {
+	if fp.redactKeys {
+		return fp.valuesOnly
+	}
+	return fp.matchKey(key)
+}
+
+// rangeValueFields calls f for populated map_value_fields of the map value m,
+// parent holds the last field of fields and path leads to its value
+func (fp fieldPlan) rangeValueFields(path protopath.Values, m protoreflect.Message, f func(path protopath.Values, parent protoreflect.Message, fields []protoreflect.FieldDescriptor) error) error {
+	for _, fields := range fp.valueFields {
+		valuePath, parent := path, m
+		for i, fd := range fields {
+			if !parent.Has(fd) {
+				break
+			}
+			v := parent.Get(fd)
+			valuePath = appendStep(valuePath, protopath.FieldAccess(fd), v)
+			if i == len(fields)-1 {
+				if err := f(valuePath, parent, fields); err != nil {
+					return err
+				}
+				break
+			}
+			parent = v.Message()
+		}
+	}
+	return nil
+}
+
+func isKeyPattern(key string) bool {
+	if !strings.ContainsAny(key, "*?[") {
+		return false
+	}
+	_, err := path.Match(key, "")
+	return err == nil
+}
+
+// mapValueFields resolves map_value_fields against the map value type and drops paths the value plan redacts anyway,
+// ok is false when the rule is not set or cannot be applied
+func mapValueFields(fd protoreflect.FieldDescriptor, annotation proto.Message, a annotations) (fields [][]protoreflect.FieldDescriptor, ok bool) {
+	names, _ := annotationStrings(annotation, "map_value_fields")
+	md := fd.MapValue().Message()
+	if len(names) == 0 || md == nil {
+		return nil, false
+	}
+	for _, name := range names {
+		fieldPath, ok := resolveFieldPath(md, name)
+		if !ok {
+			return nil, false
+		}
+		if !redactedPath(fieldPath, a) {
+			fields = append(fields, fieldPath)
+		}
+	}
+	return fields, true
+}
+
+// resolveFieldPath resolves dot separated field names starting from md, all but the last field must be singular messages
+func resolveFieldPath(md protoreflect.MessageDescriptor, name string) ([]protoreflect.FieldDescriptor, bool) {
+	var result []protoreflect.FieldDescriptor
+	for _, part := range strings.Split(name, ".") {
+		if md == nil {
+			return nil, false
+		}
+		fd := md.Fields().ByName(protoreflect.Name(part))
+		if fd == nil {
+			return nil, false
+		}
+		result = append(result, fd)
+		md = nil
+		if !fd.IsList() && !fd.IsMap() {
+			md = fd.Message()
+		}
+	}
+	return result, true
+}
+
+// redactedPath reports whether a field on fieldPath is sensitive on its own, so walking into the value redacts it
+func redactedPath(fieldPath []protoreflect.FieldDescriptor, a annotations) bool {
+	for _, fd := range fieldPath {
+		if annotation, ok := readAnnotation(fd.Options(), a.field); ok && fd.IsMap() {
+			// compiling the map rule here could recurse into this map, only an empty rule hides the whole map
+			keys, ok := annotationStrings(annotation, "map_keys_to_redact")
+			exprs, _ := annotationStrings(annotation, "map_key_regexps")
+			fields, _ := annotationStrings(annotation, "map_value_fields")
+			if !ok || len(keys) == 0 && len(exprs) == 0 && len(fields) == 0 {
+				return true
+			}
+			continue
+		}
+		if compilePolicy(fd, a).sensitive {
+			return true
+		}
+	}
+	return false
+}
+
+// annotationStrings returns the repeated string field name of annotation, ok is false if there is no such field.
+// The field is read by name, so generated and dynamic annotations are treated the same
+func annotationStrings(annotation proto.Message, name protoreflect.Name) ([]string, bool) {
+	if annotation == nil {
+		return nil, false
+	}
+	m := annotation.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(name)
+	if fd == nil || !fd.IsList() || fd.Kind() != protoreflect.StringKind {
+		return nil, false
+	}
+	list := m.Get(fd).List()
+	result := make([]string, list.Len())
+	for i := range result {
+		result[i] = list.Get(i).String()
+	}
+	return result, true
+}
+
+func associate[T any, K comparable, V any](collection []T, transform func(item T) (K, V)) map[K]V {
+	result := make(map[K]V, len(collection))
+
+	for _, t := range collection {
+		k, v := transform(t)
+		result[k] = v
+	}
+
+	return result
+}
+
+/*
+rewriteKeys replaces keys of the entries of the map field fd of parent with keys set by h, values are kept.
+h gets each key as the key field of a map entry message, so scalar handlers apply to keys as to values.
+All keys are rewritten before the map is changed: a rewritten key equal to another rewritten or kept key
+fails with keyCollisionError leaving the map untouched, otherwise entries are moved in key order
+and values of colliding keys are merged into the present ones, proto.Merge for messages, the latter value for scalars
+*/
+func rewriteKeys(path protopath.Values, parent protoreflect.Message, fd protoreflect.FieldDescriptor, fp fieldPlan, keys []protoreflect.MapKey, h Handler) error {
+	type entry struct {
+		key   protoreflect.MapKey
+		value protoreflect.Value
+	}
+	valueMap := parent.Mutable(fd).Map()
+	keyField := fd.MapKey()
+	rewritten := make(map[interface{}]bool, len(keys))
+	for _, key := range keys {
+		rewritten[key.Interface()] = true
+	}
+	entries := make([]entry, 0, len(keys))
+	taken := make(map[interface{}]bool, len(keys))
+	collision := false
+	for _, key := range keys {
+		value := valueMap.Get(key)
+		holder := dynamicpb.NewMessage(fd.Message())
+		holder.Set(keyField, key.Value())
+		err := h.Handle(HandlerContext{
+			Values:     copyValues(appendStep(path, protopath.MapIndex(key), value)),
+			Parent:     holder,
+			Field:      keyField,
+			Annotation: fp.annotation,
+			ListIndex:  -1,
+			Value:      key.Value(),
+		})
+		if err != nil {
+			return err
+		}
+		newKey := holder.Get(keyField).MapKey()
+		kept := valueMap.Has(newKey) && !rewritten[newKey.Interface()]
+		collision = collision || taken[newKey.Interface()] || kept
+		taken[newKey.Interface()] = true
+		entries = append(entries, entry{key: newKey, value: value})
+	}
+	// keys are sensitive, so the error does not name them
+	if collision && fp.keyCollisionError {
+		return fmt.Errorf("protoredact: redacted keys of %s collide", fd.FullName())
+	}
+	for _, key := range keys {
+		valueMap.Clear(key)
+	}
+	for _, e := range entries {
+		if !valueMap.Has(e.key) {
+			valueMap.Set(e.key, e.value)
+			continue
+		}
+		if fd.MapValue().Message() != nil {
+			proto.Merge(valueMap.Mutable(e.key).Message().Interface(), e.value.Message().Interface())
+			continue
+		}
+		valueMap.Set(e.key, e.value)
+	}
+	return nil
+}
@@ -0,0 +1,390 @@
+package protoredact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"regexp"
+	"sync"
+	"sync/atomic"
+)
+
+// maxCachedPlans bounds the plan cache: descriptors rebuilt at runtime are new keys every time,
+// so the cache is dropped as a whole once it holds more plans
+const maxCachedPlans = 1 << 14
+
+// planCache caches *messagePlan by planKey, so FieldOptions are reflected once per message type
+type planCache struct {
+	plans sync.Map
+	size  atomic.Int64
+}
+
+var plans atomic.Pointer[planCache]
+
+func init() {
+	ResetPlanCache()
+}
+
+// ResetPlanCache drops compiled plans. Plans are cached per descriptor, so services rebuilding descriptors at runtime
+// may call it after dropping old descriptors to free their plans sooner than the cache bound does
+func ResetPlanCache() {
+	plans.Store(&planCache{})
+}
+
+// planKey holds descriptors rather than names: dynamic descriptors and extension types
+// may share full names with generated ones while their options are decoded differently
+type planKey struct {
+	annotations annotations
+	message     protoreflect.MessageDescriptor
+}
+
+// annotations are the extensions a plan is compiled for
+type annotations struct {
+	// field extends FieldOptions
+	field protoreflect.ExtensionType
+	// message extends MessageOptions, fields holding messages of annotated types are sensitive
+	message protoreflect.ExtensionType
+	// file extends FileOptions, fields declared in annotated files are sensitive
+	file protoreflect.ExtensionType
+	// safe extends FieldOptions, marked fields are not sensitive by message or file annotations
+	safe protoreflect.ExtensionType
+	// allowlist makes every field not marked safe sensitive
+	allowlist bool
+	// enumValue extends EnumValueOptions, annotated values of enum fields are sensitive
+	enumValue protoreflect.ExtensionType
+	// oneof extends OneofOptions, members of annotated oneofs are sensitive
+	oneof protoreflect.ExtensionType
+}
+
+func (a annotations) empty() bool {
+	return a.field == nil && a.message == nil && a.file == nil && !a.allowlist && a.enumValue == nil && a.oneof == nil
+}
+
+// messagePlan is the compiled redaction plan of a message type
+type messagePlan struct {
+	annotations annotations
+	fields      []fieldPlan // indexed by protoreflect.FieldDescriptor.Index
+	// reachable means a message of this type can contain sensitive data
+	reachable bool
+	// relevant lists indexes of fields which are redacted or lead to redacted fields
+	relevant []int
+	// extensible messages may get annotated extension fields, they are always reachable
+	extensible bool
+}
+
+type fieldPlan struct {
+	// annotation is the value of the annotation making the field sensitive, nil for not annotated fields
+	annotation proto.Message
+	// sensitive means the whole field is passed to RedactingHandler
+	sensitive bool
+	// reason tells why the field is sensitive
+	reason string
+	// keysToHide is not empty for maps where only the listed keys are redacted, patterns are listed as exact keys too
+	keysToHide map[string]bool
+	// keyPatterns are glob patterns of map_keys_to_redact, see path.Match
+	keyPatterns []string
+	// keyRegexps are map_key_regexps compiled once per field
+	keyRegexps []*regexp.Regexp
+	// foldKeys means map keys are matched ignoring case, keysToHide and keyPatterns are lower case
+	foldKeys bool
+	// redactKeys means keys of matching entries are rewritten by Redactor.MapKeyHandler instead of hiding values
+	redactKeys bool
+	// keyCollisionError makes colliding rewritten keys an error instead of merging their values
+	keyCollisionError bool
+	// valuesOnly means map_value_fields is set: matching entries are kept except for valueFields
+	valuesOnly bool
+	// valueFields are paths of map_value_fields which the plan of the value type does not redact anyway
+	valueFields [][]protoreflect.FieldDescriptor
+	// descend means the field holds messages which can contain sensitive data
+	descend bool
+	// keep means the field is kept as is with its whole subtree, see Redactor.Allowlist
+	keep bool
+	// hideVariant means the field is a member of a oneof which is cleared instead of being passed to the handler
+	hideVariant bool
+	// enumValues holds annotations of sensitive values of the enum field, list elements and map values included
+	enumValues map[protoreflect.EnumNumber]proto.Message
+}
+
+func planFor(md protoreflect.MessageDescriptor, a annotations) *messagePlan {
+	cache := plans.Load()
+	if p, ok := cache.plans.Load(planKey{annotations: a, message: md}); ok {
+		return p.(*messagePlan)
+	}
+	return compileGraph(cache, md, a)
+}
+
+// compileGraph compiles md and every message type reachable from it and returns the plan of md,
+// plans are stored only when reachability of the whole graph is known
+func compileGraph(cache *planCache, md protoreflect.MessageDescriptor, a annotations) *messagePlan {
+	root := md.FullName()
+	graph := map[protoreflect.FullName]*messagePlan{}
+	var compiled []protoreflect.MessageDescriptor
+	var visit func(md protoreflect.MessageDescriptor)
+	visit = func(md protoreflect.MessageDescriptor) {
+		if _, ok := graph[md.FullName()]; ok {
+			return
+		}
+		if p, ok := cache.plans.Load(planKey{annotations: a, message: md}); ok {
+			graph[md.FullName()] = p.(*messagePlan)
+			return
+		}
+		graph[md.FullName()] = compileMessage(md, a)
+		compiled = append(compiled, md)
+		fields := md.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			if child := fieldMessage(fields.Get(i)); child != nil {
+				visit(child)
+			}
+		}
+	}
+	visit(md)
+
+	// recursive types make the graph cyclic, so propagate reachability until nothing changes
+	for changed := true; changed; {
+		changed = false
+		for _, md := range compiled {
+			p := graph[md.FullName()]
+			fields := md.Fields()
+			for i := range p.fields {
+				child := fieldMessage(fields.Get(i))
+				if child == nil || p.fields[i].sensitive || p.fields[i].keep || p.fields[i].descend || !graph[child.FullName()].reachable {
+					continue
+				}
+				p.fields[i].descend = true
+				p.reachable = true
+				changed = true
+			}
+		}
+	}
+
+	for _, md := range compiled {
+		p := graph[md.FullName()]
+		for i, fp := range p.fields {
+			if fp.sensitive || fp.byEntry() || fp.descend || len(fp.enumValues) > 0 {
+				p.relevant = append(p.relevant, i)
+			}
+		}
+		stored, loaded := cache.plans.LoadOrStore(planKey{annotations: a, message: md}, p)
+		if !loaded && cache.size.Add(1) > maxCachedPlans {
+			plans.CompareAndSwap(cache, &planCache{})
+		}
+		graph[md.FullName()] = stored.(*messagePlan)
+	}
+	return graph[root]
+}
+
+func compileMessage(md protoreflect.MessageDescriptor, a annotations) *messagePlan {
+	fields := md.Fields()
+	p := &messagePlan{
+		annotations: a,
+		fields:      make([]fieldPlan, fields.Len()),
+		extensible:  md.ExtensionRanges().Len() > 0,
+	}
+	// in allowlist mode unknown fields of any message are dropped
+	p.reachable = p.extensible || a.allowlist
+	for i := range p.fields {
+		p.fields[i] = compileField(fields.Get(i), a)
+		p.reachable = p.reachable || p.fields[i].sensitive || p.fields[i].byEntry() || len(p.fields[i].enumValues) > 0
+	}
+	return p
+}
+
+func (p *messagePlan) field(fd protoreflect.FieldDescriptor) fieldPlan {
+	if !fd.IsExtension() {
+		return p.fields[fd.Index()]
+	}
+	fp := compileField(fd, p.annotations)
+	if child := fieldMessage(fd); child != nil && !fp.sensitive && !fp.keep {
+		fp.descend = planFor(child, p.annotations).reachable
+	}
+	return fp
+}
+
+// populated returns the populated fields of m which the plan cares about together with their values
+func (p *messagePlan) populated(m protoreflect.Message) ([]protoreflect.FieldDescriptor, []protoreflect.Value) {
+	var (
+		fields []protoreflect.FieldDescriptor
+		values []protoreflect.Value
+		all    = m.Descriptor().Fields()
+	)
+	for _, i := range p.relevant {
+		fd := all.Get(i)
+		if m.Has(fd) {
+			fields = append(fields, fd)
+			values = append(values, m.Get(fd))
+		}
+	}
+	if p.extensible {
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if fd.IsExtension() {
+				fields = append(fields, fd)
+				values = append(values, v)
+			}
+			return true
+		})
+	}
+	return fields, values
+}
+
+// fieldMessage returns the message type held by fd, for maps it is the type of values
+func fieldMessage(fd protoreflect.FieldDescriptor) protoreflect.MessageDescriptor {
+	if fd.IsMap() {
+		return fd.MapValue().Message()
+	}
+	return fd.Message()
+}
+
+func compileField(fd protoreflect.FieldDescriptor, a annotations) fieldPlan {
+	fp := compilePolicy(fd, a)
+	if !fp.sensitive {
+		fp.enumValues = sensitiveEnumValues(fd, a.enumValue)
+	}
+	return fp
+}
+
+// compilePolicy resolves the policy of fd: a oneof with hide_variant first, then its own annotation or safe marker,
+// then the annotation of its oneof, then the annotation of its message type, then allowlist mode,
+// then the default of the file declaring it
+func compilePolicy(fd protoreflect.FieldDescriptor, a annotations) fieldPlan {
+	oneof, inOneof := oneofPlan(fd, a)
+	if inOneof && oneof.hideVariant {
+		// the set member tells the variant, so it is hidden whatever the member policy is
+		return oneof
+	}
+	if annotation, ok := readAnnotation(fd.Options(), a.field); ok {
+		return compileAnnotated(fd, annotation, a)
+	}
+	md := fieldMessage(fd)
+	if readFlag(fd.Options(), a.safe) {
+		return fieldPlan{keep: a.allowlist && md != nil}
+	}
+	if inOneof {
+		return oneof
+	}
+	if md != nil {
+		if annotation, ok := readAnnotation(md.Options(), a.message); ok {
+			return fieldPlan{annotation: annotation, sensitive: true, reason: reasonMessageAnnotated}
+		}
+	}
+	if a.allowlist {
+		// leaves are redacted, messages are descended into to keep the structure.
+		// Maps are leaves whatever their values are: keys are often sensitive themselves and show up in paths
+		return fieldPlan{sensitive: md == nil || fd.IsMap(), reason: reasonNotSafe}
+	}
+	if annotation, ok := readAnnotation(fd.ParentFile().Options(), a.file); ok {
+		return fieldPlan{annotation: annotation, sensitive: true, reason: reasonFileAnnotated}
+	}
+	return fieldPlan{}
+}
+
+// oneofPlan returns the plan of members of the annotated oneof containing fd and whether there is one
+func oneofPlan(fd protoreflect.FieldDescriptor, a annotations) (fieldPlan, bool) {
+	od := fd.ContainingOneof()
+	if od == nil || od.IsSynthetic() {
+		return fieldPlan{}, false
+	}
+	annotation, ok := readAnnotation(od.Options(), a.oneof)
+	if !ok {
+		return fieldPlan{}, false
+	}
+	return fieldPlan{
+		annotation:  annotation,
+		sensitive:   true,
+		reason:      reasonOneofAnnotated,
+		hideVariant: annotationBool(annotation, "hide_variant"),
+	}, true
+}
+
+// sensitiveEnumValues returns annotations of values of the enum held by fd which are annotated with annotation
+func sensitiveEnumValues(fd protoreflect.FieldDescriptor, annotation protoreflect.ExtensionType) map[protoreflect.EnumNumber]proto.Message {
+	ed := fd.Enum()
+	if fd.IsMap() {
+		ed = fd.MapValue().Enum()
+	}
+	if ed == nil || annotation == nil {
+		return nil
+	}
+	var result map[protoreflect.EnumNumber]proto.Message
+	values := ed.Values()
+	for i := 0; i < values.Len(); i++ {
+		if valueAnnotation, ok := readAnnotation(values.Get(i).Options(), annotation); ok {
+			if result == nil {
+				result = map[protoreflect.EnumNumber]proto.Message{}
+			}
+			result[values.Get(i).Number()] = valueAnnotation
+		}
+	}
+	return result
+}
+
+func compileAnnotated(fd protoreflect.FieldDescriptor, annotation proto.Message, a annotations) fieldPlan {
+	if fd.IsMap() {
+		return compileMap(fd, annotation, a)
+	}
+	return fieldPlan{annotation: annotation, sensitive: true, reason: reasonAnnotated}
+}
+
+// readAnnotation returns the value of annotation in opts and whether it is set, the value is nil for non-message annotations
+func readAnnotation(opts protoreflect.ProtoMessage, annotation protoreflect.ExtensionType) (proto.Message, bool) {
+	m, ok := parseAnnotation(opts, annotation)
+	if !ok {
+		return nil, false
+	}
+	xd := annotation.TypeDescriptor()
+	if m == nil || xd.Message() == nil || xd.IsList() {
+		return nil, true
+	}
+	// proto.GetExtension gives protoreflect.Message for dynamic extension types, so the value is read via reflection
+	return m.Get(xd).Message().Interface(), true
+}
+
+// readFlag reports whether marker is set in opts, bool markers must be true. Options which cannot be parsed are not marked
+func readFlag(opts protoreflect.ProtoMessage, marker protoreflect.ExtensionType) bool {
+	m, ok := parseAnnotation(opts, marker)
+	if !ok || m == nil {
+		return false
+	}
+	xd := marker.TypeDescriptor()
+	if xd.Kind() == protoreflect.BoolKind && !xd.IsList() {
+		return m.Get(xd).Bool()
+	}
+	return true
+}
+
+// parseAnnotation returns opts with annotation available and whether it is set.
+// Descriptors built from a FileDescriptorSet at runtime often keep options as unknown fields,
+// they are parsed against annotation then. Options which cannot be parsed are treated as annotated, nil is returned for them
+func parseAnnotation(opts protoreflect.ProtoMessage, annotation protoreflect.ExtensionType) (protoreflect.Message, bool) {
+	if opts == nil || annotation == nil {
+		return nil, false
+	}
+	if proto.HasExtension(opts, annotation) {
+		return opts.ProtoReflect(), true
+	}
+	unknown := opts.ProtoReflect().GetUnknown()
+	if len(unknown) == 0 {
+		return nil, false
+	}
+	types := new(protoregistry.Types)
+	if err := types.RegisterExtension(annotation); err != nil {
+		return nil, true
+	}
+	parsed := opts.ProtoReflect().Type().New().Interface()
+	if err := (proto.UnmarshalOptions{Resolver: types}).Unmarshal(unknown, parsed); err != nil {
+		return nil, true
+	}
+	if !proto.HasExtension(parsed, annotation) {
+		return nil, false
+	}
+	return parsed.ProtoReflect(), true
+}
+
+// annotationBool returns the value of the bool field name of annotation, false if there is no such field
+func annotationBool(annotation proto.Message, name protoreflect.Name) bool {
+	if annotation == nil {
+		return false
+	}
+	m := annotation.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(name)
+	return fd != nil && fd.Kind() == protoreflect.BoolKind && !fd.IsList() && m.Get(fd).Bool()
+}
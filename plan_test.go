@@ -0,0 +1,145 @@
+package protoredact
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sync"
+	"testing"
+)
+
+func TestPlanFor(t *testing.T) {
+	t.Parallel()
+	md := (&testproto.WithAllFieldTypes_Internal{}).ProtoReflect().Descriptor()
+
+	got := make([]*messagePlan, 8)
+	var wg sync.WaitGroup
+	for i := range got {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got[i] = planFor(md, annotations{field: testproto.E_SensitiveData})
+		}(i)
+	}
+	wg.Wait()
+	for _, p := range got {
+		assert.Same(t, got[0], p)
+	}
+
+	fields := md.Fields()
+	p := got[0]
+	assert.Equal(t, fieldPlan{}, withoutAnnotation(p.field(fields.ByName("fieldInt64"))))
+	assert.Equal(t, fieldPlan{descend: true}, withoutAnnotation(p.field(fields.ByName("recursive"))))
+	assert.Equal(t, fieldPlan{sensitive: true, reason: reasonAnnotated}, withoutAnnotation(p.field(fields.ByName("fieldStringSensitive"))))
+	assert.Equal(t, fieldPlan{sensitive: true, reason: reasonAnnotated}, withoutAnnotation(p.field(fields.ByName("recursiveSensitive"))))
+	assert.Equal(t, fieldPlan{sensitive: true, reason: reasonAnnotated}, withoutAnnotation(p.field(fields.ByName("sensitiveMap"))))
+	assert.Equal(t, fieldPlan{keysToHide: map[string]bool{"hide_this_key": true}, descend: true}, withoutAnnotation(p.field(fields.ByName("mapWithSensitiveKey"))))
+	assert.Equal(t, fieldPlan{keysToHide: map[string]bool{"87654": true}, descend: true}, withoutAnnotation(p.field(fields.ByName("mapWithSensitiveKeyIntKey"))))
+}
+
+func TestPlanFor_Reachable(t *testing.T) {
+	t.Parallel()
+	root := (&testproto.WithAllFieldTypes{}).ProtoReflect().Descriptor()
+	p := planFor(root, annotations{field: testproto.E_SensitiveData})
+	assert.True(t, p.reachable)
+	assert.Equal(t, fieldPlan{descend: true}, withoutAnnotation(p.field(root.Fields().ByName("messageList"))))
+	assert.Equal(t, fieldPlan{descend: true}, withoutAnnotation(p.field(root.Fields().ByName("mapField"))))
+	assert.Equal(t, fieldPlan{}, withoutAnnotation(p.field(root.Fields().ByName("plainList"))))
+
+	plain := planFor((&testproto.Plain{}).ProtoReflect().Descriptor(), annotations{field: testproto.E_SensitiveData})
+	assert.False(t, plain.reachable)
+	assert.Empty(t, plain.relevant)
+}
+
+func TestPlanFor_MessageAnnotation(t *testing.T) {
+	t.Parallel()
+	md := (&testproto.Order{}).ProtoReflect().Descriptor()
+	fields := md.Fields()
+	p := planFor(md, annotations{field: testproto.E_SensitiveData, message: testproto.E_SensitiveMessage})
+	assert.True(t, p.reachable)
+	assert.Equal(t, fieldPlan{}, withoutAnnotation(p.field(fields.ByName("id"))))
+	assert.Equal(t, fieldPlan{descend: true}, withoutAnnotation(p.field(fields.ByName("parent"))))
+	for _, name := range []protoreflect.Name{"card", "cards", "cardsByName"} {
+		assert.Equal(t, fieldPlan{sensitive: true, reason: reasonMessageAnnotated}, withoutAnnotation(p.field(fields.ByName(name))), name)
+	}
+	// field annotation takes precedence
+	masked := p.field(fields.ByName("maskedCard"))
+	assert.Equal(t, reasonAnnotated, masked.reason)
+	assert.Equal(t, testproto.Strategy_STRATEGY_MASK, masked.annotation.(*testproto.SensitiveData).GetStrategy())
+
+	assert.False(t, planFor(md, annotations{field: testproto.E_SensitiveData}).field(fields.ByName("card")).sensitive)
+}
+
+func TestPlanFor_MapEntryRules(t *testing.T) {
+	t.Parallel()
+	md := (&testproto.Accounts{}).ProtoReflect().Descriptor()
+	fields := md.Fields()
+	p := planFor(md, annotations{field: testproto.E_SensitiveData})
+
+	byPattern := p.field(fields.ByName("byPattern"))
+	assert.Equal(t, fieldPlan{
+		keysToHide:  map[string]bool{"*_token": true, "secret": true, "items[0]": true},
+		keyPatterns: []string{"*_token", "items[0]"},
+		descend:     true,
+	}, withoutAnnotation(byPattern))
+	// patterns match literally too
+	for key, match := range map[string]bool{"secret": true, "card_token": true, "_token": true, "token": false, "secret_token_id": false, "items[0]": true, "items0": true} {
+		assert.Equal(t, match, byPattern.matchKey(key), key)
+	}
+
+	// paths already redacted by the value type are dropped
+	internals := p.field(fields.ByName("internals"))
+	internal := (&testproto.WithAllFieldTypes_Internal{}).ProtoReflect().Descriptor()
+	assert.True(t, internals.valuesOnly)
+	assert.Equal(t, [][]protoreflect.FieldDescriptor{{internal.Fields().ByName("fieldInt64")}}, internals.valueFields)
+	assert.True(t, internals.matchKey("any"))
+
+	details := p.field(fields.ByName("details"))
+	assert.Len(t, details.valueFields, 2)
+	assert.Len(t, details.valueFields[1], 2)
+	assert.False(t, p.field(fields.ByName("tokenDetails")).matchKey("id"))
+
+	// unresolvable paths hide the whole field
+	assert.Equal(t, fieldPlan{sensitive: true, reason: reasonAnnotated}, withoutAnnotation(p.field(fields.ByName("typo"))))
+	// so do options which cannot be parsed and non-message annotations
+	assert.Equal(t, fieldPlan{sensitive: true, reason: reasonAnnotated}, compileAnnotated(fields.ByName("details"), nil, annotations{field: testproto.E_SensitiveData}))
+}
+
+func TestPlanFor_MapKeyRegexps(t *testing.T) {
+	t.Parallel()
+	md := (&testproto.HttpRequest{}).ProtoReflect().Descriptor()
+	fields := md.Fields()
+	a := annotations{field: testproto.E_SensitiveData}
+
+	headers := planFor(md, a).field(fields.ByName("headers"))
+	assert.True(t, headers.foldKeys)
+	assert.Equal(t, map[string]bool{"authorization": true, "x-api-key*": true}, headers.keysToHide)
+	assert.Equal(t, []string{"x-api-key*"}, headers.keyPatterns)
+	assert.Len(t, headers.keyRegexps, 1)
+	// compiled once per field
+	assert.Same(t, headers.keyRegexps[0], planFor(md, a).field(fields.ByName("headers")).keyRegexps[0])
+
+	// invalid regexps hide the whole field
+	assert.Equal(t, fieldPlan{sensitive: true, reason: reasonAnnotated}, withoutAnnotation(planFor(md, a).field(fields.ByName("broken"))))
+}
+
+// not parallel: resetting the cache would break identity checks of other tests
+func TestResetPlanCache(t *testing.T) {
+	md := (&testproto.WithAllFieldTypes_Internal{}).ProtoReflect().Descriptor()
+	a := annotations{field: testproto.E_SensitiveData}
+	p := planFor(md, a)
+	ResetPlanCache()
+	assert.NotSame(t, p, planFor(md, a))
+	assert.Equal(t, p, planFor(md, a))
+
+	// the cache is dropped when it overflows
+	cache := plans.Load()
+	cache.size.Store(maxCachedPlans)
+	planFor((&testproto.Plain{}).ProtoReflect().Descriptor(), a)
+	assert.NotSame(t, cache, plans.Load())
+}
+
+func withoutAnnotation(fp fieldPlan) fieldPlan {
+	fp.annotation = nil
+	return fp
+}
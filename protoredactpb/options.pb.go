@@ -0,0 +1,561 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v4.25.1
+// source: protoredact/options.proto
+
+package protoredactpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Format int32
+
+const (
+	Format_FORMAT_UNSPECIFIED Format = 0
+	Format_FORMAT_CARD        Format = 1
+	Format_FORMAT_EMAIL       Format = 2
+	Format_FORMAT_PHONE       Format = 3
+	Format_FORMAT_IBAN        Format = 4
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_CARD",
+		2: "FORMAT_EMAIL",
+		3: "FORMAT_PHONE",
+		4: "FORMAT_IBAN",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_CARD":        1,
+		"FORMAT_EMAIL":       2,
+		"FORMAT_PHONE":       3,
+		"FORMAT_IBAN":        4,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_protoredact_options_proto_enumTypes[0].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_protoredact_options_proto_enumTypes[0]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_protoredact_options_proto_rawDescGZIP(), []int{0}
+}
+
+type Strategy int32
+
+const (
+	Strategy_STRATEGY_UNSPECIFIED Strategy = 0
+	Strategy_STRATEGY_CLEAR       Strategy = 1
+	Strategy_STRATEGY_MASK        Strategy = 2
+	Strategy_STRATEGY_HASH        Strategy = 3
+	Strategy_STRATEGY_PLACEHOLDER Strategy = 4
+	Strategy_STRATEGY_KEEP_LAST_N Strategy = 5
+)
+
+// Enum value maps for Strategy.
+var (
+	Strategy_name = map[int32]string{
+		0: "STRATEGY_UNSPECIFIED",
+		1: "STRATEGY_CLEAR",
+		2: "STRATEGY_MASK",
+		3: "STRATEGY_HASH",
+		4: "STRATEGY_PLACEHOLDER",
+		5: "STRATEGY_KEEP_LAST_N",
+	}
+	Strategy_value = map[string]int32{
+		"STRATEGY_UNSPECIFIED": 0,
+		"STRATEGY_CLEAR":       1,
+		"STRATEGY_MASK":        2,
+		"STRATEGY_HASH":        3,
+		"STRATEGY_PLACEHOLDER": 4,
+		"STRATEGY_KEEP_LAST_N": 5,
+	}
+)
+
+func (x Strategy) Enum() *Strategy {
+	p := new(Strategy)
+	*p = x
+	return p
+}
+
+func (x Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_protoredact_options_proto_enumTypes[1].Descriptor()
+}
+
+func (Strategy) Type() protoreflect.EnumType {
+	return &file_protoredact_options_proto_enumTypes[1]
+}
+
+func (x Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Strategy.Descriptor instead.
+func (Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_protoredact_options_proto_rawDescGZIP(), []int{1}
+}
+
+// strategy, format, placeholder, keep_last_n and preserve_length are read by handlers.Default and handlers.Strategies,
+// protoredact.Default ignores them and clears the field
+type SensitiveData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//if set, hides only specified keys, otherwise the whole field; keys with *, ? or [ are glob patterns, e.g. "*_token",
+	//which match the same key literally too
+	MapKeysToRedact []string `protobuf:"bytes,1,rep,name=map_keys_to_redact,json=mapKeysToRedact,proto3" json:"map_keys_to_redact,omitempty"`
+	//if set, format-preserving handlers keep the shape of the value
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=protoredact.Format" json:"format,omitempty"`
+	//if set, handlers.Strategies picks the treatment of the field
+	Strategy Strategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=protoredact.Strategy" json:"strategy,omitempty"`
+	//replacement for STRATEGY_PLACEHOLDER
+	Placeholder string `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	//number of characters kept by STRATEGY_KEEP_LAST_N
+	KeepLastN uint32 `protobuf:"varint,5,opt,name=keep_last_n,json=keepLastN,proto3" json:"keep_last_n,omitempty"`
+	//STRATEGY_MASK keeps the original length
+	PreserveLength bool `protobuf:"varint,6,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	//for oneofs, the set member is cleared instead of being passed to the handler, so the chosen variant is hidden too,
+	//whatever annotation or safe marker the member has
+	HideVariant bool `protobuf:"varint,7,opt,name=hide_variant,json=hideVariant,proto3" json:"hide_variant,omitempty"`
+	//for maps of messages, hides only these fields of the values, dot separated for nested messages, e.g. "card.number";
+	//applies to entries matching map_keys_to_redact or to all entries
+	MapValueFields []string `protobuf:"bytes,8,rep,name=map_value_fields,json=mapValueFields,proto3" json:"map_value_fields,omitempty"`
+	//RE2 patterns of map keys to hide, matching anywhere in the key unless anchored with ^ and $
+	MapKeyRegexps []string `protobuf:"bytes,9,rep,name=map_key_regexps,json=mapKeyRegexps,proto3" json:"map_key_regexps,omitempty"`
+	//map_keys_to_redact and map_key_regexps ignore case, e.g. for HTTP headers
+	MapKeysCaseInsensitive bool `protobuf:"varint,10,opt,name=map_keys_case_insensitive,json=mapKeysCaseInsensitive,proto3" json:"map_keys_case_insensitive,omitempty"`
+	//keys of matching entries, or of all entries if no keys are listed, are rewritten by Redactor.MapKeyHandler, values are kept
+	RedactMapKeys bool `protobuf:"varint,11,opt,name=redact_map_keys,json=redactMapKeys,proto3" json:"redact_map_keys,omitempty"`
+	//with redact_map_keys, a rewritten key colliding with another one fails redaction instead of merging the values
+	ErrorOnKeyCollision bool `protobuf:"varint,12,opt,name=error_on_key_collision,json=errorOnKeyCollision,proto3" json:"error_on_key_collision,omitempty"`
+}
+
+func (x *SensitiveData) Reset() {
+	*x = SensitiveData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protoredact_options_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SensitiveData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitiveData) ProtoMessage() {}
+
+func (x *SensitiveData) ProtoReflect() protoreflect.Message {
+	mi := &file_protoredact_options_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitiveData.ProtoReflect.Descriptor instead.
+func (*SensitiveData) Descriptor() ([]byte, []int) {
+	return file_protoredact_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SensitiveData) GetMapKeysToRedact() []string {
+	if x != nil {
+		return x.MapKeysToRedact
+	}
+	return nil
+}
+
+func (x *SensitiveData) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *SensitiveData) GetStrategy() Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Strategy_STRATEGY_UNSPECIFIED
+}
+
+func (x *SensitiveData) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *SensitiveData) GetKeepLastN() uint32 {
+	if x != nil {
+		return x.KeepLastN
+	}
+	return 0
+}
+
+func (x *SensitiveData) GetPreserveLength() bool {
+	if x != nil {
+		return x.PreserveLength
+	}
+	return false
+}
+
+func (x *SensitiveData) GetHideVariant() bool {
+	if x != nil {
+		return x.HideVariant
+	}
+	return false
+}
+
+func (x *SensitiveData) GetMapValueFields() []string {
+	if x != nil {
+		return x.MapValueFields
+	}
+	return nil
+}
+
+func (x *SensitiveData) GetMapKeyRegexps() []string {
+	if x != nil {
+		return x.MapKeyRegexps
+	}
+	return nil
+}
+
+func (x *SensitiveData) GetMapKeysCaseInsensitive() bool {
+	if x != nil {
+		return x.MapKeysCaseInsensitive
+	}
+	return false
+}
+
+func (x *SensitiveData) GetRedactMapKeys() bool {
+	if x != nil {
+		return x.RedactMapKeys
+	}
+	return false
+}
+
+func (x *SensitiveData) GetErrorOnKeyCollision() bool {
+	if x != nil {
+		return x.ErrorOnKeyCollision
+	}
+	return false
+}
+
+var file_protoredact_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1291,
+		Name:          "protoredact.sensitive",
+		Tag:           "bytes,1291,opt,name=sensitive",
+		Filename:      "protoredact/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1292,
+		Name:          "protoredact.safe_to_log",
+		Tag:           "varint,1292,opt,name=safe_to_log",
+		Filename:      "protoredact/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1291,
+		Name:          "protoredact.sensitive_message",
+		Tag:           "bytes,1291,opt,name=sensitive_message",
+		Filename:      "protoredact/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1291,
+		Name:          "protoredact.sensitive_file",
+		Tag:           "bytes,1291,opt,name=sensitive_file",
+		Filename:      "protoredact/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1291,
+		Name:          "protoredact.sensitive_value",
+		Tag:           "bytes,1291,opt,name=sensitive_value",
+		Filename:      "protoredact/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1291,
+		Name:          "protoredact.sensitive_oneof",
+		Tag:           "bytes,1291,opt,name=sensitive_oneof",
+		Filename:      "protoredact/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional protoredact.SensitiveData sensitive = 1291;
+	E_Sensitive = &file_protoredact_options_proto_extTypes[0]
+	//marks the field as fine to log despite message and file annotations
+	//
+	// optional bool safe_to_log = 1292;
+	E_SafeToLog = &file_protoredact_options_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional protoredact.SensitiveData sensitive_message = 1291;
+	E_SensitiveMessage = &file_protoredact_options_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional protoredact.SensitiveData sensitive_file = 1291;
+	E_SensitiveFile = &file_protoredact_options_proto_extTypes[3]
+)
+
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// optional protoredact.SensitiveData sensitive_value = 1291;
+	E_SensitiveValue = &file_protoredact_options_proto_extTypes[4]
+)
+
+// Extension fields to descriptorpb.OneofOptions.
+var (
+	// optional protoredact.SensitiveData sensitive_oneof = 1291;
+	E_SensitiveOneof = &file_protoredact_options_proto_extTypes[5]
+)
+
+var File_protoredact_options_proto protoreflect.FileDescriptor
+
+var file_protoredact_options_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x94, 0x04, 0x0a, 0x0d, 0x53,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x12,
+	0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x64, 0x61,
+	0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x70, 0x4b, 0x65, 0x79,
+	0x73, 0x54, 0x6f, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x66, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52,
+	0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6b,
+	0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x72,
+	0x69, 0x61, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x69, 0x64, 0x65,
+	0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x70, 0x5f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x6d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x67,
+	0x65, 0x78, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x61, 0x70, 0x4b,
+	0x65, 0x79, 0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6d, 0x61,
+	0x70, 0x4b, 0x65, 0x79, 0x73, 0x43, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x33, 0x0a, 0x16,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c,
+	0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x4f, 0x6e, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x2a, 0x66, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x43, 0x41,
+	0x52, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x45,
+	0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x49, 0x42, 0x41, 0x4e, 0x10, 0x04, 0x2a, 0x92, 0x01, 0x0a, 0x08, 0x53, 0x74,
+	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45,
+	0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x43, 0x4c, 0x45,
+	0x41, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x52, 0x41, 0x54,
+	0x45, 0x47, 0x59, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x03, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54,
+	0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x50, 0x4c, 0x41, 0x43, 0x45, 0x48, 0x4f, 0x4c, 0x44,
+	0x45, 0x52, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59,
+	0x5f, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x4e, 0x10, 0x05, 0x3a, 0x58,
+	0x0a, 0x09, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8b, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x2e,
+	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x73,
+	0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x3a, 0x3e, 0x0a, 0x0b, 0x73, 0x61, 0x66, 0x65,
+	0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x6f, 0x67, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8c, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73,
+	0x61, 0x66, 0x65, 0x54, 0x6f, 0x4c, 0x6f, 0x67, 0x3a, 0x69, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8b,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x3a, 0x60, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x8b, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x46, 0x69, 0x6c, 0x65, 0x3a, 0x67, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x8b, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74,
+	0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x63,
+	0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x8b, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4f, 0x6e,
+	0x65, 0x6f, 0x66, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x79, 0x6f, 0x6e, 0x65, 0x73, 0x6b, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x61, 0x63,
+	0x74, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protoredact_options_proto_rawDescOnce sync.Once
+	file_protoredact_options_proto_rawDescData = file_protoredact_options_proto_rawDesc
+)
+
+func file_protoredact_options_proto_rawDescGZIP() []byte {
+	file_protoredact_options_proto_rawDescOnce.Do(func() {
+		file_protoredact_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_protoredact_options_proto_rawDescData)
+	})
+	return file_protoredact_options_proto_rawDescData
+}
+
+var file_protoredact_options_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_protoredact_options_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_protoredact_options_proto_goTypes = []interface{}{
+	(Format)(0),                           // 0: protoredact.Format
+	(Strategy)(0),                         // 1: protoredact.Strategy
+	(*SensitiveData)(nil),                 // 2: protoredact.SensitiveData
+	(*descriptorpb.FieldOptions)(nil),     // 3: google.protobuf.FieldOptions
+	(*descriptorpb.MessageOptions)(nil),   // 4: google.protobuf.MessageOptions
+	(*descriptorpb.FileOptions)(nil),      // 5: google.protobuf.FileOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 6: google.protobuf.EnumValueOptions
+	(*descriptorpb.OneofOptions)(nil),     // 7: google.protobuf.OneofOptions
+}
+var file_protoredact_options_proto_depIdxs = []int32{
+	0,  // 0: protoredact.SensitiveData.format:type_name -> protoredact.Format
+	1,  // 1: protoredact.SensitiveData.strategy:type_name -> protoredact.Strategy
+	3,  // 2: protoredact.sensitive:extendee -> google.protobuf.FieldOptions
+	3,  // 3: protoredact.safe_to_log:extendee -> google.protobuf.FieldOptions
+	4,  // 4: protoredact.sensitive_message:extendee -> google.protobuf.MessageOptions
+	5,  // 5: protoredact.sensitive_file:extendee -> google.protobuf.FileOptions
+	6,  // 6: protoredact.sensitive_value:extendee -> google.protobuf.EnumValueOptions
+	7,  // 7: protoredact.sensitive_oneof:extendee -> google.protobuf.OneofOptions
+	2,  // 8: protoredact.sensitive:type_name -> protoredact.SensitiveData
+	2,  // 9: protoredact.sensitive_message:type_name -> protoredact.SensitiveData
+	2,  // 10: protoredact.sensitive_file:type_name -> protoredact.SensitiveData
+	2,  // 11: protoredact.sensitive_value:type_name -> protoredact.SensitiveData
+	2,  // 12: protoredact.sensitive_oneof:type_name -> protoredact.SensitiveData
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	8,  // [8:13] is the sub-list for extension type_name
+	2,  // [2:8] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_protoredact_options_proto_init() }
+func file_protoredact_options_proto_init() {
+	if File_protoredact_options_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protoredact_options_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SensitiveData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_protoredact_options_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   1,
+			NumExtensions: 6,
+			NumServices:   0,
+		},
+		GoTypes:           file_protoredact_options_proto_goTypes,
+		DependencyIndexes: file_protoredact_options_proto_depIdxs,
+		EnumInfos:         file_protoredact_options_proto_enumTypes,
+		MessageInfos:      file_protoredact_options_proto_msgTypes,
+		ExtensionInfos:    file_protoredact_options_proto_extTypes,
+	}.Build()
+	File_protoredact_options_proto = out.File
+	file_protoredact_options_proto_rawDesc = nil
+	file_protoredact_options_proto_goTypes = nil
+	file_protoredact_options_proto_depIdxs = nil
+}
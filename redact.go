@@ -1,15 +1,14 @@
 package protoredact
 
 import (
+	"context"
 	"fmt"
+	"github.com/yonesko/protoredact/protoredactpb"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protopath"
-	"google.golang.org/protobuf/reflect/protorange"
 	"google.golang.org/protobuf/reflect/protoreflect"
-	"google.golang.org/protobuf/runtime/protoimpl"
-	"google.golang.org/protobuf/types/descriptorpb"
-	"os"
-	"sync"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"log/slog"
 )
 
 var (
@@ -17,95 +16,215 @@ var (
 		parent.Message().Clear(fd)
 		return nil
 	}
-	o sync.Once
+)
+
+// Default clears fields annotated with (protoredact.sensitive) from protoredact/options.proto.
+// It ignores strategy, format and placeholder of the annotation, handlers.Default dispatches on them.
+// Experimental: the extension numbers of options.proto are not assigned in the protobuf global extension registry
+// and will change once they are, declare your own annotation for production schemas meanwhile
+var Default = Redactor{
+	SensitiveFieldAnnotation:     protoredactpb.E_Sensitive,
+	SensitiveMessageAnnotation:   protoredactpb.E_SensitiveMessage,
+	SensitiveFileAnnotation:      protoredactpb.E_SensitiveFile,
+	SafeFieldAnnotation:          protoredactpb.E_SafeToLog,
+	SensitiveEnumValueAnnotation: protoredactpb.E_SensitiveValue,
+	SensitiveOneofAnnotation:     protoredactpb.E_SensitiveOneof,
+	RedactingHandler:             clearFunc,
+}
+
+const fieldOptionsName protoreflect.FullName = "google.protobuf.FieldOptions"
+
+const (
+	reasonAnnotated        = "field is annotated as sensitive"
+	reasonMessageAnnotated = "message type is annotated as sensitive"
+	reasonFileAnnotated    = "file is annotated as sensitive by default"
+	reasonNotSafe          = "field is not marked as safe in allowlist mode"
+	reasonUnknownFields    = "unknown fields are not marked as safe in allowlist mode"
+	reasonEnumValue        = "enum value is annotated as sensitive"
+	reasonOneofAnnotated   = "oneof is annotated as sensitive"
+	reasonMapKey           = "map key is listed in map_keys_to_redact"
+	reasonMapValueField    = "map value field is listed in map_value_fields"
+	reasonMapKeyRewritten  = "map keys are annotated with redact_map_keys"
 )
 
 type Redactor struct {
-	SensitiveFieldAnnotation *protoimpl.ExtensionInfo
-	RedactingHandler         func(parent protoreflect.Value, field protoreflect.FieldDescriptor) error
+	SensitiveFieldAnnotation protoreflect.ExtensionType
+	// SensitiveMessageAnnotation extends MessageOptions, every field holding a message of an annotated type is sensitive
+	// unless the field has its own SensitiveFieldAnnotation
+	SensitiveMessageAnnotation protoreflect.ExtensionType
+	// SensitiveFileAnnotation extends FileOptions, every field declared in an annotated file is sensitive by default.
+	// A field policy is resolved in order: field annotation or SafeFieldAnnotation, message annotation, file annotation
+	SensitiveFileAnnotation protoreflect.ExtensionType
+	// SafeFieldAnnotation extends FieldOptions, it marks fields which are fine to log despite message and file annotations,
+	// bool extensions must be true
+	SafeFieldAnnotation protoreflect.ExtensionType
+	// Allowlist is fail-closed mode: every leaf field not marked with SafeFieldAnnotation is sensitive,
+	// so new fields are hidden by default. Lists of scalars and all maps are leaves, messages are descended into
+	// to keep the structure readable, including list elements and oneof members.
+	// Map keys are often sensitive themselves, so a map is kept only when the map field is marked safe.
+	// A message field marked safe is kept with its whole subtree, e.g. google.protobuf.Timestamp.
+	// Unknown fields are dropped from every other message, they are fields added to the schema after the binary was built.
+	// Field and message annotations still apply
+	Allowlist        bool
+	RedactingHandler func(parent protoreflect.Value, field protoreflect.FieldDescriptor) error
+	// Handler takes precedence over RedactingHandler
+	Handler Handler
+	// MapEntryHandler handles entries with keys matching map_keys_to_redact, ZeroMapValue by default.
+	// Sub-fields listed in map_value_fields go to the field handler instead, with Parent being the message holding them
+	MapEntryHandler Handler
+	// MapKeyHandler rewrites keys of maps annotated with redact_map_keys, Redact fails on such maps if it is nil:
+	// clearing handlers would make all keys equal and merge the values.
+	// It gets a key as Field of a detached map entry message and must leave the new key there, e.g. with HandlerContext.Set
+	MapKeyHandler Handler
+	// restoreMapKeys passes every key of redact_map_keys maps to MapKeyHandler, see RestoringMapKeys
+	restoreMapKeys bool
+	// SensitiveEnumValueAnnotation extends EnumValueOptions, enum fields holding an annotated value are passed
+	// to EnumValueHandler, list elements and map values one by one
+	SensitiveEnumValueAnnotation protoreflect.ExtensionType
+	// EnumValueHandler replaces sensitive enum values, ReplaceEnumValue(0) by default
+	EnumValueHandler Handler
+	// SensitiveOneofAnnotation extends OneofOptions, the set member of an annotated oneof is sensitive.
+	// If the annotation has hide_variant set, the member is cleared instead of being passed to the handler,
+	// so the chosen variant is hidden too, even for members annotated or marked safe themselves
+	SensitiveOneofAnnotation protoreflect.ExtensionType
+	// Logger, if set, receives a debug record per redacted path with the reason, values are never logged
+	Logger *slog.Logger
+}
+
+// NewRedactor makes a Redactor clearing fields annotated with the extension named annotation, e.g. "mycorp.sensitive_data".
+// resolver finds the extension, protoregistry.GlobalTypes if nil
+func NewRedactor(annotation protoreflect.FullName, resolver protoregistry.ExtensionTypeResolver) (Redactor, error) {
+	if resolver == nil {
+		resolver = protoregistry.GlobalTypes
+	}
+	xt, err := resolver.FindExtensionByName(annotation)
+	if err != nil {
+		return Redactor{}, fmt.Errorf("protoredact: resolve annotation %s: %w", annotation, err)
+	}
+	if extendee := xt.TypeDescriptor().ContainingMessage().FullName(); extendee != fieldOptionsName {
+		return Redactor{}, fmt.Errorf("protoredact: annotation %s extends %s, not %s", annotation, extendee, fieldOptionsName)
+	}
+	return Redactor{SensitiveFieldAnnotation: xt, RedactingHandler: clearFunc}, nil
+}
+
+// RestoringMapKeys returns r passing every key of redact_map_keys maps to h, as rewritten keys no longer match
+// the rules which selected them. It is for reversing redaction, see handlers.Unredactor
+func (r Redactor) RestoringMapKeys(h Handler) Redactor {
+	r.MapKeyHandler = h
+	r.restoreMapKeys = true
+	return r
 }
 
 func (r Redactor) Redact(msg proto.Message) error {
-	o.Do(func() {
-		fmt.Println(os.Environ())
-	})
-	if r.SensitiveFieldAnnotation == nil || r.RedactingHandler == nil {
+	handler := r.handler()
+	if r.annotations().empty() || handler == nil || msg == nil {
 		return nil
 	}
-	return protorange.Range(msg.ProtoReflect(), func(p protopath.Values) error {
-		fd := p.Path.Index(-1).FieldDescriptor()
-		if isFieldSensetive(fd, p.Index(-1).Value, r.SensitiveFieldAnnotation) {
-			parent := p.Index(-2)
-			if parent.Value.IsValid() {
-				err := r.RedactingHandler(parent.Value, fd)
-				if err != nil {
-					return err
-				}
-			}
-		}
-
+	m := msg.ProtoReflect()
+	if !m.IsValid() {
 		return nil
-	})
+	}
+	return r.inPlace().message(rootPath(m), m)
 }
 
-func Redact(msg proto.Message, sensitiveFieldAnnotation *protoimpl.ExtensionInfo) error {
-	return Redactor{RedactingHandler: clearFunc, SensitiveFieldAnnotation: sensitiveFieldAnnotation}.Redact(msg)
+// inPlace makes a walker calling handlers on visited locations, the handler must not be nil
+func (r Redactor) inPlace() walker {
+	handler := r.handler()
+	entryHandler := r.mapEntryHandler()
+	enumHandler := r.enumValueHandler()
+	return walker{redactor: r, clearUnknown: r.Allowlist, visit: func(loc location) error {
+		r.logRedacted(loc.path.Path, loc.reason)
+		if len(loc.keys) > 0 {
+			if r.MapKeyHandler == nil {
+				return fmt.Errorf("protoredact: %s is annotated with redact_map_keys, but Redactor.MapKeyHandler is not set", loc.field.FullName())
+			}
+			return rewriteKeys(loc.path, loc.parent, loc.field, loc.plan, loc.keys, r.MapKeyHandler)
+		}
+		if loc.plan.hideVariant {
+			loc.parent.Clear(loc.field)
+			return nil
+		}
+		c := HandlerContext{
+			Values:     copyValues(loc.path),
+			Parent:     loc.parent,
+			Field:      loc.field,
+			Annotation: loc.annotation(),
+			MapKey:     loc.key,
+			ListIndex:  loc.index,
+			Value:      loc.path.Index(-1).Value,
+		}
+		switch {
+		case loc.enumValue:
+			return enumHandler.Handle(c)
+		case loc.key.IsValid():
+			return entryHandler.Handle(c)
+		}
+		return handler.Handle(c)
+	}}
 }
 
-func isFieldSensetive(fieldDescriptor protoreflect.FieldDescriptor, value protoreflect.Value, sensitiveFieldAnnotation *protoimpl.ExtensionInfo) bool {
-	if fieldDescriptor == nil {
-		return false
-	}
-	opts, ok := fieldDescriptor.Options().(*descriptorpb.FieldOptions)
-	if !ok {
-		return false
+func (r Redactor) annotations() annotations {
+	return annotations{
+		field:     r.SensitiveFieldAnnotation,
+		message:   r.SensitiveMessageAnnotation,
+		file:      r.SensitiveFileAnnotation,
+		safe:      r.SafeFieldAnnotation,
+		allowlist: r.Allowlist,
+		enumValue: r.SensitiveEnumValueAnnotation,
+		oneof:     r.SensitiveOneofAnnotation,
 	}
-	if !proto.HasExtension(opts, sensitiveFieldAnnotation) {
-		return false
+}
+
+func (r Redactor) handler() Handler {
+	if r.Handler != nil {
+		return r.Handler
 	}
-	if fieldDescriptor.IsMap() {
-		return handleMapType(fieldDescriptor, value, opts, sensitiveFieldAnnotation)
+	if r.RedactingHandler != nil {
+		return FieldHandler(r.RedactingHandler)
 	}
-	return true
+	return nil
 }
 
-/*
-if GetMapKeysToRedact is empty, hide the whole field, otherwise hide only specified keys
-*/
-func handleMapType(fd protoreflect.FieldDescriptor, value protoreflect.Value, opts *descriptorpb.FieldOptions, sensitiveFieldAnnotation *protoimpl.ExtensionInfo) bool {
-	if !fd.IsMap() || !value.Map().IsValid() {
-		return false
+func (r Redactor) mapEntryHandler() Handler {
+	if r.MapEntryHandler != nil {
+		return r.MapEntryHandler
 	}
-	ext, ok := proto.GetExtension(opts, sensitiveFieldAnnotation).(interface {
-		GetMapKeysToRedact() []string
-	})
-	if !ok {
-		return false
-	}
-	keysToHide := associate(ext.GetMapKeysToRedact(), func(item string) (string, bool) {
-		return item, true
-	})
-	if len(keysToHide) == 0 {
-		return true
+	return ZeroMapValue
+}
+
+func (r Redactor) enumValueHandler() Handler {
+	if r.EnumValueHandler != nil {
+		return r.EnumValueHandler
 	}
+	return ReplaceEnumValue(0)
+}
 
-	valueMap := value.Map()
-	valueMap.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
-		if keysToHide[key.String()] {
-			valueMap.Set(key, valueMap.NewValue())
-		}
-		return true
-	})
-	return false
+func (r Redactor) logRedacted(path protopath.Path, reason string) {
+	if r.Logger == nil {
+		return
+	}
+	r.Logger.LogAttrs(context.Background(), slog.LevelDebug, "protoredact: redacted",
+		slog.String("path", path.String()),
+		slog.String("reason", reason),
+	)
 }
 
-func associate[T any, K comparable, V any](collection []T, transform func(item T) (K, V)) map[K]V {
-	result := make(map[K]V, len(collection))
+// pathCapacity is enough for typical nesting, so appendStep rarely allocates
+const pathCapacity = 16
 
-	for _, t := range collection {
-		k, v := transform(t)
-		result[k] = v
+func rootPath(m protoreflect.Message) protopath.Values {
+	return protopath.Values{
+		Path:   append(make(protopath.Path, 0, pathCapacity), protopath.Root(m.Descriptor())),
+		Values: append(make([]protoreflect.Value, 0, pathCapacity), protoreflect.ValueOfMessage(m)),
 	}
+}
+
+// appendStep extends path in DFS manner: siblings reuse the same backing arrays,
+// so the result is valid only until the next sibling step is appended
+func appendStep(path protopath.Values, step protopath.Step, v protoreflect.Value) protopath.Values {
+	return protopath.Values{Path: append(path.Path, step), Values: append(path.Values, v)}
+}
 
-	return result
+func Redact(msg proto.Message, sensitiveFieldAnnotation protoreflect.ExtensionType) error {
+	return Redactor{RedactingHandler: clearFunc, SensitiveFieldAnnotation: sensitiveFieldAnnotation}.Redact(msg)
 }
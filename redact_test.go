@@ -1,11 +1,19 @@
 package protoredact
 
 import (
+	"bytes"
 	"encoding/json"
 	"github.com/stretchr/testify/assert"
 	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"log/slog"
+	"strings"
 	"testing"
 )
 
@@ -132,6 +140,7 @@ func TestRedactProto_Clear(t *testing.T) {
 		},
 	}
 	for _, tt := range tests {
+		dynamic := dynamicCopy(t, tt.args.message)
 		t.Run(tt.name, func(t *testing.T) {
 			err := redactor.Redact(tt.args.message)
 			if (err != nil) != tt.wantErr {
@@ -141,8 +150,36 @@ func TestRedactProto_Clear(t *testing.T) {
 			assert.True(t, proto.Equal(tt.want, tt.args.message))
 			assert.Equal(t, string(must(json.Marshal(tt.want))), string(must(json.Marshal(tt.args.message))))
 		})
+		t.Run(tt.name+" dynamicpb", func(t *testing.T) {
+			err := redactor.Redact(dynamic)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("SensetiveFields() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			got := tt.want.ProtoReflect().New().Interface()
+			assert.NoError(t, proto.Unmarshal(must(proto.Marshal(dynamic)), got))
+			assert.True(t, proto.Equal(tt.want, got), got)
+		})
 	}
 }
+
+// dynamicCopy copies message into a dynamicpb.Message of a descriptor built at runtime,
+// field options of the descriptor are unknown bytes as if the FileDescriptorSet was parsed without annotations registered
+func dynamicCopy(t *testing.T, message proto.Message) *dynamicpb.Message {
+	md := message.ProtoReflect().Descriptor()
+	fdp := &descriptorpb.FileDescriptorProto{}
+	unmarshal := proto.UnmarshalOptions{Resolver: new(protoregistry.Types)}
+	assert.NoError(t, unmarshal.Unmarshal(must(proto.Marshal(protodesc.ToFileDescriptorProto(md.ParentFile()))), fdp))
+	file, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	assert.NoError(t, err)
+	dmd := file.Messages().ByName(md.Name())
+	assert.False(t, proto.HasExtension(dmd.Fields().ByName("fieldStringSensitive").Options(), testproto.E_SensitiveData))
+
+	dynamic := dynamicpb.NewMessage(dmd)
+	assert.NoError(t, proto.Unmarshal(must(proto.Marshal(message)), dynamic))
+	return dynamic
+}
+
 func TestRedactProto_SetStringClearOther(t *testing.T) {
 	t.Parallel()
 	redactor := Redactor{
@@ -243,6 +280,576 @@ func TestRedactProto_SetStringClearOther(t *testing.T) {
 	}
 }
 
+func TestRedactor_RedactClone(t *testing.T) {
+	t.Parallel()
+	redactors := map[string]Redactor{
+		"clear": {RedactingHandler: clearFunc, SensitiveFieldAnnotation: testproto.E_SensitiveData},
+		"set string clear other": {
+			RedactingHandler: func(parent protoreflect.Value, field protoreflect.FieldDescriptor) error {
+				if field.Kind() == protoreflect.StringKind {
+					parent.Message().Set(field, protoreflect.ValueOfString("REDACTED"))
+				} else {
+					parent.Message().Clear(field)
+				}
+				return nil
+			},
+			SensitiveFieldAnnotation: testproto.E_SensitiveData,
+		},
+	}
+	message := &testproto.WithAllFieldTypes{
+		PaymentToken:         &testproto.WithAllFieldTypes_Cryptogram{Cryptogram: "earnest"},
+		FieldInt64:           418,
+		FieldStringSensitive: "pad",
+		MessageListSensitive: []*testproto.WithAllFieldTypes_Internal{{FieldInt64: 915}},
+		MessageList: []*testproto.WithAllFieldTypes_Internal{
+			{FieldInt64: 145, FieldStringSensitive: "progress"},
+			{SensitiveMap: map[string]*testproto.WithAllFieldTypes_Internal{"surround": {}}},
+			{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+				"detail":        {FieldInt64: 948, FieldStringSensitive: "Conubiafeugiat"},
+				"hide_this_key": {FieldInt64: 999},
+			}},
+			{MapWithSensitiveKeyIntKey: map[int64]*testproto.WithAllFieldTypes_Internal{87654: {FieldInt64: 948}, 642: {FieldInt64: 651}}},
+			{Recursive: &testproto.WithAllFieldTypes_Internal{
+				Recursive:          &testproto.WithAllFieldTypes_Internal{FieldInt64: 530, FieldIntSensitive: 434},
+				RecursiveSensitive: &testproto.WithAllFieldTypes_Internal{FieldInt64: 732},
+			}},
+		},
+		MapField:  map[string]*testproto.WithAllFieldTypes_Internal{"reckless": {FieldStringSensitive: "jury"}},
+		PlainList: []*testproto.Plain{{FieldString: "mirror", Recursive: &testproto.Plain{FieldInt64: 1}}},
+	}
+	for name, redactor := range redactors {
+		t.Run(name, func(t *testing.T) {
+			original := proto.Clone(message)
+			want := proto.Clone(message)
+			assert.NoError(t, redactor.Redact(want))
+
+			got, err := redactor.RedactClone(message)
+			assert.NoError(t, err)
+			assert.True(t, proto.Equal(want, got))
+			assert.True(t, proto.Equal(original, message))
+		})
+	}
+}
+
+func TestRedactor_Logger(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	redactor := Redactor{
+		RedactingHandler:         clearFunc,
+		SensitiveFieldAnnotation: testproto.E_SensitiveData,
+		Logger:                   slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})),
+	}
+	message := &testproto.WithAllFieldTypes{
+		FieldInt64:           418,
+		FieldStringSensitive: "pad",
+		MessageList: []*testproto.WithAllFieldTypes_Internal{
+			{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{"hide_this_key": {FieldInt64: 999}}},
+		},
+	}
+
+	assert.NoError(t, redactor.Redact(message))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `path=(testproto.WithAllFieldTypes).fieldStringSensitive reason="field is annotated as sensitive"`)
+	assert.Contains(t, lines[1], `path="(testproto.WithAllFieldTypes).messageList[0].mapWithSensitiveKey[\"hide_this_key\"]" reason="map key is listed in map_keys_to_redact"`)
+	assert.NotContains(t, buf.String(), "pad")
+	assert.NotContains(t, buf.String(), "999")
+}
+
+func TestNewRedactor(t *testing.T) {
+	t.Parallel()
+	newMessage := func() *testproto.WithAllFieldTypes {
+		return &testproto.WithAllFieldTypes{
+			FieldInt64:           418,
+			FieldStringSensitive: "pad",
+			MessageList: []*testproto.WithAllFieldTypes_Internal{
+				{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{"hide_this_key": {FieldInt64: 999}, "detail": {FieldInt64: 948}}},
+			},
+		}
+	}
+	want := newMessage()
+	assert.NoError(t, Redact(want, testproto.E_SensitiveData))
+
+	global, err := NewRedactor("testproto.sensitive_data", nil)
+	assert.NoError(t, err)
+	message := newMessage()
+	assert.NoError(t, global.Redact(message))
+	assert.True(t, proto.Equal(want, message), message)
+
+	// extension type resolved at runtime, annotations are dynamicpb messages
+	types := new(protoregistry.Types)
+	assert.NoError(t, types.RegisterExtension(dynamicpb.NewExtensionType(testproto.E_SensitiveData.TypeDescriptor().Descriptor())))
+	dynamic, err := NewRedactor("testproto.sensitive_data", types)
+	assert.NoError(t, err)
+	message = newMessage()
+	assert.NoError(t, dynamic.Redact(message))
+	assert.True(t, proto.Equal(want, message), message)
+
+	_, err = NewRedactor("testproto.missing", types)
+	assert.ErrorIs(t, err, protoregistry.NotFound)
+}
+
+func TestRedactor_SensitiveMessageAnnotation(t *testing.T) {
+	t.Parallel()
+	redactor := Redactor{
+		RedactingHandler:           clearFunc,
+		SensitiveFieldAnnotation:   testproto.E_SensitiveData,
+		SensitiveMessageAnnotation: testproto.E_SensitiveMessage,
+	}
+	newMessage := func() *testproto.Order {
+		card := func() *testproto.CardDetails {
+			return &testproto.CardDetails{Number: "4111111111111111", Holder: "John Doe"}
+		}
+		return &testproto.Order{
+			Id:          "1",
+			Card:        card(),
+			Cards:       []*testproto.CardDetails{card()},
+			CardsByName: map[string]*testproto.CardDetails{"main": card()},
+			MaskedCard:  card(),
+			Parent:      &testproto.Order{Id: "0", Card: card()},
+		}
+	}
+	want := &testproto.Order{Id: "1", Parent: &testproto.Order{Id: "0"}}
+
+	message := newMessage()
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(want, message), message)
+
+	cloned, err := redactor.RedactClone(newMessage())
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(want, cloned), cloned)
+
+	findings, err := redactor.Inspect(newMessage())
+	assert.NoError(t, err)
+	assert.Len(t, findings, 5)
+	assert.Equal(t, "(testproto.Order).card", findings[0].Path.String())
+}
+
+func TestRedactor_SensitiveFileAnnotation(t *testing.T) {
+	t.Parallel()
+	redactor := Redactor{
+		Handler:                  FieldHandler(clearFunc),
+		SensitiveFieldAnnotation: testproto.E_SensitiveData,
+		SensitiveFileAnnotation:  testproto.E_SensitiveFile,
+		SafeFieldAnnotation:      testproto.E_SafeToLog,
+	}
+	message := &testproto.Patient{
+		Id:         "42",
+		Name:       "John Doe",
+		Notes:      map[string]string{"allergy": "penicillin"},
+		LastVisit:  &testproto.Visit{Date: "2024-06-01", Doctor: "House"},
+		FirstVisit: &testproto.Visit{Date: "2020-01-01", Doctor: "Cuddy"},
+		Email:      "john@example.com",
+		Plain:      &testproto.Plain{FieldString: "plain"},
+		Diagnosis:  "lupus",
+	}
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(&testproto.Patient{
+		Id:        "42",
+		LastVisit: &testproto.Visit{Date: "2024-06-01"},
+		Plain:     &testproto.Plain{FieldString: "plain"},
+	}, message), message)
+
+	var redacted []string
+	redactor.Handler = HandlerFunc(func(c HandlerContext) error {
+		redacted = append(redacted, string(c.Field.Name()))
+		return nil
+	})
+	redactor.SensitiveFileAnnotation = nil
+	assert.NoError(t, redactor.Redact(&testproto.Patient{Name: "John Doe", Email: "john@example.com"}))
+	assert.Equal(t, []string{"email"}, redacted)
+}
+
+func TestRedactor_Allowlist(t *testing.T) {
+	t.Parallel()
+	redactor := Redactor{
+		RedactingHandler:           clearFunc,
+		SensitiveFieldAnnotation:   testproto.E_SensitiveData,
+		SensitiveMessageAnnotation: testproto.E_SensitiveMessage,
+		SafeFieldAnnotation:        testproto.E_SafeToLog,
+		Allowlist:                  true,
+	}
+	newMessage := func() *testproto.Event {
+		return &testproto.Event{
+			Id:        "1",
+			UserEmail: "john@example.com",
+			Amount:    100,
+			Tags:      []string{"vip"},
+			Labels:    map[string]string{"region": "eu"},
+			Plains:    map[string]*testproto.Plain{"a": {FieldInt64: 1, Recursive: &testproto.Plain{FieldString: "b"}}},
+			Subject:   &testproto.Event_PlainSubject{PlainSubject: &testproto.Plain{FieldString: "c"}},
+			Kept:      &testproto.Plain{FieldInt64: 2, FieldString: "kept"},
+			Kind:      testproto.Enum1_ENUM_1_VAL_1,
+			Secret:    "secret",
+			Card:      &testproto.CardDetails{Number: "4111111111111111"},
+		}
+	}
+	want := &testproto.Event{
+		Id:      "1",
+		Subject: &testproto.Event_PlainSubject{PlainSubject: &testproto.Plain{}},
+		Kept:    &testproto.Plain{FieldInt64: 2, FieldString: "kept"},
+		Kind:    testproto.Enum1_ENUM_1_VAL_1,
+	}
+
+	message := newMessage()
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(want, message), message)
+
+	cloned, err := redactor.RedactClone(newMessage())
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(want, cloned), cloned)
+
+	// unknown fields are dropped except in kept subtrees, they are fields added after the binary was built
+	unknown := protowire.AppendString(protowire.AppendTag(nil, 100, protowire.BytesType), "john@example.com")
+	newUnknown := func() *testproto.Event {
+		message := newMessage()
+		message.ProtoReflect().SetUnknown(unknown)
+		message.GetPlainSubject().ProtoReflect().SetUnknown(unknown)
+		message.Kept.ProtoReflect().SetUnknown(unknown)
+		return message
+	}
+	wantUnknown := proto.Clone(want).(*testproto.Event)
+	wantUnknown.Kept.ProtoReflect().SetUnknown(unknown)
+	message = newUnknown()
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(wantUnknown, message), message)
+	cloned, err = redactor.RedactClone(newUnknown())
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(wantUnknown, cloned), cloned)
+
+	message = newMessage()
+	message.Subject = &testproto.Event_UserId{UserId: "42"}
+	findings, err := redactor.Inspect(message)
+	assert.NoError(t, err)
+	paths := make([]string, len(findings))
+	for i, f := range findings {
+		paths[i] = f.Path.String()
+	}
+	assert.Equal(t, []string{
+		"(testproto.Event).userEmail",
+		"(testproto.Event).amount",
+		"(testproto.Event).tags",
+		"(testproto.Event).labels",
+		"(testproto.Event).plains",
+		"(testproto.Event).userId",
+		"(testproto.Event).secret",
+		"(testproto.Event).card",
+	}, paths)
+}
+
+func TestRedactor_SensitiveEnumValueAnnotation(t *testing.T) {
+	t.Parallel()
+	newMessage := func() *testproto.MedicalRecord {
+		return &testproto.MedicalRecord{
+			Diagnosis: testproto.Diagnosis_DIAGNOSIS_HIV,
+			History:   []testproto.Diagnosis{testproto.Diagnosis_DIAGNOSIS_FLU, testproto.Diagnosis_DIAGNOSIS_HIV},
+			ByVisit:   map[string]testproto.Diagnosis{"a": testproto.Diagnosis_DIAGNOSIS_HIV, "b": testproto.Diagnosis_DIAGNOSIS_FLU},
+			Related:   []*testproto.MedicalRecord{{Diagnosis: testproto.Diagnosis_DIAGNOSIS_FLU}, {Diagnosis: testproto.Diagnosis_DIAGNOSIS_HIV}},
+		}
+	}
+	redactor := Redactor{
+		RedactingHandler:             clearFunc,
+		SensitiveEnumValueAnnotation: testproto.E_SensitiveValue,
+		EnumValueHandler:             ReplaceEnumValue(protoreflect.EnumNumber(testproto.Diagnosis_DIAGNOSIS_REDACTED)),
+	}
+	want := &testproto.MedicalRecord{
+		Diagnosis: testproto.Diagnosis_DIAGNOSIS_REDACTED,
+		History:   []testproto.Diagnosis{testproto.Diagnosis_DIAGNOSIS_FLU, testproto.Diagnosis_DIAGNOSIS_REDACTED},
+		ByVisit:   map[string]testproto.Diagnosis{"a": testproto.Diagnosis_DIAGNOSIS_REDACTED, "b": testproto.Diagnosis_DIAGNOSIS_FLU},
+		Related:   []*testproto.MedicalRecord{{Diagnosis: testproto.Diagnosis_DIAGNOSIS_FLU}, {Diagnosis: testproto.Diagnosis_DIAGNOSIS_REDACTED}},
+	}
+
+	message := newMessage()
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(want, message), message)
+	assert.NoError(t, redactor.Verify(message))
+
+	original := newMessage()
+	cloned, err := redactor.RedactClone(original)
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(want, cloned), cloned)
+	assert.True(t, proto.Equal(newMessage(), original))
+
+	var sensitiveErr *SensitiveDataError
+	assert.ErrorAs(t, redactor.Verify(newMessage()), &sensitiveErr)
+	assert.Len(t, sensitiveErr.Findings, 4)
+
+	// zero is the default fallback
+	redactor.EnumValueHandler = nil
+	message = &testproto.MedicalRecord{Diagnosis: testproto.Diagnosis_DIAGNOSIS_HIV}
+	assert.NoError(t, redactor.Redact(message))
+	assert.Equal(t, testproto.Diagnosis_DIAGNOSIS_UNSPECIFIED, message.Diagnosis)
+}
+
+func TestRedactor_SensitiveOneofAnnotation(t *testing.T) {
+	t.Parallel()
+	var redacted []string
+	redactor := Redactor{
+		Handler: HandlerFunc(func(c HandlerContext) error {
+			redacted = append(redacted, string(c.Field.Name()))
+			c.Set(protoreflect.ValueOfString("REDACTED"))
+			return nil
+		}),
+		SensitiveFieldAnnotation: testproto.E_SensitiveData,
+		SensitiveOneofAnnotation: testproto.E_SensitiveOneof,
+	}
+
+	// the unannotated member is sensitive too
+	message := &testproto.WithAllFieldTypes{PaymentToken: &testproto.WithAllFieldTypes_Token{Token: "tok"}}
+	assert.NoError(t, redactor.Redact(message))
+	assert.Equal(t, "REDACTED", message.GetToken())
+	assert.Equal(t, []string{"token"}, redacted)
+
+	// hide_variant clears the member without calling the handler
+	newPayments := func() []*testproto.Payment {
+		return []*testproto.Payment{
+			{Id: "1", Method: &testproto.Payment_Pan{Pan: "4111111111111111"}},
+			{Id: "2", Method: &testproto.Payment_Details{Details: &testproto.Plain{FieldString: "details"}}},
+			// an annotated member is hidden too rather than passed to the handler
+			{Id: "3", Method: &testproto.Payment_Cryptogram{Cryptogram: "AAAB"}},
+		}
+	}
+	for i, payment := range newPayments() {
+		assert.NoError(t, redactor.Redact(payment))
+		assert.Nil(t, payment.Method)
+		assert.Equal(t, newPayments()[i].Id, payment.Id)
+
+		cloned, err := redactor.RedactClone(newPayments()[i])
+		assert.NoError(t, err)
+		assert.True(t, proto.Equal(payment, cloned), cloned)
+	}
+	assert.Equal(t, []string{"token"}, redacted)
+
+	findings, err := redactor.Inspect(newPayments()[0])
+	assert.NoError(t, err)
+	assert.Len(t, findings, 1)
+	assert.True(t, findings[0].Annotation.(*testproto.SensitiveData).GetHideVariant())
+}
+
+func TestRedactor_MapValueFields(t *testing.T) {
+	t.Parallel()
+	newAccounts := func() *testproto.Accounts {
+		return &testproto.Accounts{
+			ByPattern: map[string]*testproto.WithAllFieldTypes_Internal{
+				"card_token": {FieldInt64: 1},
+				"secret":     {FieldInt64: 2},
+				"name":       {FieldInt64: 3, FieldStringSensitive: "sensitive"},
+			},
+			Details: map[string]*testproto.Plain{
+				"a": {FieldInt64: 1, FieldString: "x", Recursive: &testproto.Plain{FieldInt64: 2, FieldString: "y"}},
+			},
+			TokenDetails: map[string]*testproto.Plain{
+				"api_token": {FieldInt64: 1, FieldString: "x"},
+				"id":        {FieldInt64: 2, FieldString: "y"},
+			},
+			Typo:      map[string]*testproto.Plain{"a": {FieldString: "x"}},
+			Internals: map[string]*testproto.WithAllFieldTypes_Internal{"a": {FieldInt64: 1, FieldStringSensitive: "sensitive"}},
+		}
+	}
+	var redacted []string
+	redactor := Redactor{
+		Handler: HandlerFunc(func(c HandlerContext) error {
+			redacted = append(redacted, c.Values.Path[1:].String())
+			if c.Field.Kind() == protoreflect.StringKind {
+				c.Set(protoreflect.ValueOfString("REDACTED"))
+				return nil
+			}
+			c.Clear()
+			return nil
+		}),
+		SensitiveFieldAnnotation: testproto.E_SensitiveData,
+	}
+	message := newAccounts()
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(&testproto.Accounts{
+		ByPattern: map[string]*testproto.WithAllFieldTypes_Internal{
+			"card_token": {},
+			"secret":     {},
+			"name":       {FieldInt64: 3, FieldStringSensitive: "REDACTED"},
+		},
+		Details: map[string]*testproto.Plain{
+			"a": {FieldInt64: 1, FieldString: "REDACTED", Recursive: &testproto.Plain{FieldString: "y"}},
+		},
+		TokenDetails: map[string]*testproto.Plain{
+			"api_token": {FieldInt64: 1, FieldString: "REDACTED"},
+			"id":        {FieldInt64: 2, FieldString: "y"},
+		},
+		Internals: map[string]*testproto.WithAllFieldTypes_Internal{"a": {FieldStringSensitive: "REDACTED"}},
+	}, message), message)
+	// fields redacted by the value type are handled once
+	assert.ElementsMatch(t, []string{
+		`.byPattern["name"].fieldStringSensitive`,
+		`.details["a"].fieldString`,
+		`.details["a"].recursive.fieldInt64`,
+		`.tokenDetails["api_token"].fieldString`,
+		`.typo`,
+		`.internals["a"].fieldStringSensitive`,
+		`.internals["a"].fieldInt64`,
+	}, redacted)
+
+	cloned, err := redactor.RedactClone(newAccounts())
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(message, cloned), cloned)
+
+	original := newAccounts()
+	findings, err := redactor.Inspect(original)
+	assert.NoError(t, err)
+	assert.Len(t, findings, 9)
+	assert.True(t, proto.Equal(newAccounts(), original))
+}
+
+func TestRedactor_MapKeyRegexps(t *testing.T) {
+	t.Parallel()
+	newRequest := func() *testproto.HttpRequest {
+		return &testproto.HttpRequest{
+			Headers: map[string]string{
+				"Authorization":  "Bearer 1",
+				"authorization":  "Bearer 2",
+				"X-Api-Key-Id":   "key",
+				"X-Access-Token": "token",
+				"X-Request-Id":   "id",
+				"Accept":         "*/*",
+			},
+			Cookies: map[string]string{"user_session_id": "1", "Session": "2", "theme": "dark"},
+			Broken:  map[string]string{"any": "value"},
+		}
+	}
+	redactor := Redactor{RedactingHandler: clearFunc, SensitiveFieldAnnotation: testproto.E_SensitiveData}
+	message := newRequest()
+	assert.NoError(t, redactor.Redact(message))
+	assert.True(t, proto.Equal(&testproto.HttpRequest{
+		Headers: map[string]string{
+			"Authorization":  "",
+			"authorization":  "",
+			"X-Api-Key-Id":   "",
+			"X-Access-Token": "",
+			"X-Request-Id":   "id",
+			"Accept":         "*/*",
+		},
+		// regexps are case-sensitive by default
+		Cookies: map[string]string{"user_session_id": "", "Session": "2", "theme": "dark"},
+	}, message), message)
+
+	cloned, err := redactor.RedactClone(newRequest())
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(message, cloned), cloned)
+}
+
+func TestRedactor_RedactMapKeys(t *testing.T) {
+	t.Parallel()
+	newStats := func() *testproto.UserStats {
+		return &testproto.UserStats{
+			PerUser: map[string]*testproto.Plain{
+				"alice@example.com": {FieldInt64: 1, FieldString: "a"},
+				"anna@example.com":  {FieldInt64: 2, Recursive: &testproto.Plain{FieldInt64: 3}},
+				"bob@example.com":   {FieldInt64: 4},
+			},
+			PerContact: map[string]int64{"bob@example.com": 1, "bea@example.com": 2, "local": 3},
+		}
+	}
+	redactor := Redactor{
+		Handler: HandlerFunc(func(c HandlerContext) error {
+			c.Set(protoreflect.ValueOfString("REDACTED"))
+			return nil
+		}),
+		MapKeyHandler: HandlerFunc(func(c HandlerContext) error {
+			switch c.Field.Kind() {
+			case protoreflect.StringKind:
+				c.Set(protoreflect.ValueOfString(c.Value.String()[:1] + "***"))
+			default:
+				c.Set(protoreflect.ValueOfInt64(c.Value.Int() / 1000))
+			}
+			return nil
+		}),
+		SensitiveFieldAnnotation: testproto.E_SensitiveData,
+	}
+	message := newStats()
+	assert.NoError(t, redactor.Redact(message))
+	// colliding values are merged in key order
+	assert.True(t, proto.Equal(&testproto.UserStats{
+		PerUser: map[string]*testproto.Plain{
+			"a***": {FieldInt64: 2, FieldString: "REDACTED", Recursive: &testproto.Plain{FieldInt64: 3}},
+			"b***": {FieldInt64: 4},
+		},
+		PerContact: map[string]int64{"b***": 1, "local": 3},
+	}, message), message)
+
+	cloned, err := redactor.RedactClone(newStats())
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(message, cloned), cloned)
+
+	// keys matching the listed keys are reported until rewritten, keys of perUser are never
+	var sensitiveData *SensitiveDataError
+	assert.ErrorAs(t, redactor.Verify(&testproto.UserStats{
+		PerUser:    map[string]*testproto.Plain{"alice@example.com": {}},
+		PerContact: map[string]int64{"bob@example.com": 1, "local": 3},
+	}), &sensitiveData)
+	assert.Len(t, sensitiveData.Findings, 1)
+	assert.Equal(t, `(testproto.UserStats).perContact["bob@example.com"]`, sensitiveData.Findings[0].Path.String())
+	assert.Equal(t, "protoredact: message contains sensitive data: (testproto.UserStats).perContact[<key>]", sensitiveData.Error())
+	assert.NoError(t, redactor.Verify(&testproto.UserStats{
+		PerUser:    map[string]*testproto.Plain{"alice@example.com": {}},
+		PerContact: message.PerContact,
+	}))
+
+	findings, err := redactor.Inspect(newStats())
+	assert.NoError(t, err)
+	var keys []string
+	for _, f := range findings {
+		if f.Key {
+			keys = append(keys, f.MapKey.String())
+		}
+	}
+	assert.Equal(t, []string{"alice@example.com", "anna@example.com", "bob@example.com", "bea@example.com", "bob@example.com"}, keys)
+
+	// error_on_key_collision
+	newPhones := func() *testproto.UserStats {
+		return &testproto.UserStats{PerPhone: map[int64]*testproto.Plain{
+			79001234567: {FieldInt64: 1},
+			79001234999: {FieldInt64: 2},
+			79001235000: {FieldInt64: 3},
+		}}
+	}
+	phones := newPhones()
+	err = redactor.Redact(phones)
+	assert.EqualError(t, err, "protoredact: redacted keys of testproto.UserStats.perPhone collide")
+	// nothing is lost on collision
+	assert.True(t, proto.Equal(newPhones(), phones), phones)
+	assert.NoError(t, redactor.Redact(&testproto.UserStats{PerPhone: map[int64]*testproto.Plain{79001234567: {}, 79001235999: {}}}))
+
+	// keys are never cleared by the field handler
+	message = newStats()
+	clearing := Redactor{RedactingHandler: clearFunc, SensitiveFieldAnnotation: testproto.E_SensitiveData}
+	assert.EqualError(t, clearing.Redact(message), "protoredact: testproto.UserStats.perUser is annotated with redact_map_keys, but Redactor.MapKeyHandler is not set")
+	assert.Len(t, message.PerUser, 3)
+	_, err = clearing.RedactClone(newStats())
+	assert.Error(t, err)
+
+	// restoring handlers get every key as rewritten keys no longer match map_keys_to_redact
+	var restored []string
+	restoring := redactor.RestoringMapKeys(HandlerFunc(func(c HandlerContext) error {
+		restored = append(restored, c.Value.String())
+		return nil
+	}))
+	assert.NoError(t, restoring.Redact(&testproto.UserStats{PerContact: map[string]int64{"b***": 1, "local": 3}}))
+	assert.Equal(t, []string{"b***", "local"}, restored)
+}
+
+func TestDefault(t *testing.T) {
+	t.Parallel()
+	message := &testproto.Canonical{
+		FieldStringSensitive: "secret",
+		Headers:              map[string]string{"authorization": "Bearer secret", "accept": "*/*"},
+		FieldString:          "plain",
+	}
+	assert.NoError(t, Default.Redact(message))
+	assert.True(t, proto.Equal(&testproto.Canonical{
+		Headers:     map[string]string{"authorization": "", "accept": "*/*"},
+		FieldString: "plain",
+	}, message), message)
+}
+
 /*
 goos: darwin
 goarch: arm64
@@ -269,6 +876,26 @@ func Benchmark(b *testing.B) {
 	}
 }
 
+/*
+goos: linux
+goarch: amd64
+pkg: github.com/yonesko/protoredact
+before skipping subtrees without sensitive fields:
+BenchmarkPlainList 	      49	  22528017 ns/op
+after:
+BenchmarkPlainList 	 2372238	       566.8 ns/op
+*/
+func BenchmarkPlainList(b *testing.B) {
+	msg := &testproto.WithAllFieldTypes{FieldInt64: 418, PlainList: make([]*testproto.Plain, 10000)}
+	for i := range msg.PlainList {
+		msg.PlainList[i] = &testproto.Plain{FieldInt64: int64(i), FieldString: "mirror", Recursive: &testproto.Plain{FieldInt64: 1}}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = Redact(msg, testproto.E_SensitiveData)
+	}
+}
+
 func must[T any](val T, err error) T {
 	if err != nil {
 		panic(err)
@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v4.25.1
+// source: testproto/pii.proto
+
+package testproto
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Patient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name       string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Notes      map[string]string `protobuf:"bytes,3,rep,name=notes,proto3" json:"notes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	LastVisit  *Visit            `protobuf:"bytes,4,opt,name=lastVisit,proto3" json:"lastVisit,omitempty"`
+	FirstVisit *Visit            `protobuf:"bytes,5,opt,name=firstVisit,proto3" json:"firstVisit,omitempty"`
+	Email      string            `protobuf:"bytes,6,opt,name=email,proto3" json:"email,omitempty"`
+	Plain      *Plain            `protobuf:"bytes,7,opt,name=plain,proto3" json:"plain,omitempty"`
+	Diagnosis  string            `protobuf:"bytes,8,opt,name=diagnosis,proto3" json:"diagnosis,omitempty"`
+}
+
+func (x *Patient) Reset() {
+	*x = Patient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_pii_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Patient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Patient) ProtoMessage() {}
+
+func (x *Patient) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_pii_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Patient.ProtoReflect.Descriptor instead.
+func (*Patient) Descriptor() ([]byte, []int) {
+	return file_testproto_pii_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Patient) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Patient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Patient) GetNotes() map[string]string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *Patient) GetLastVisit() *Visit {
+	if x != nil {
+		return x.LastVisit
+	}
+	return nil
+}
+
+func (x *Patient) GetFirstVisit() *Visit {
+	if x != nil {
+		return x.FirstVisit
+	}
+	return nil
+}
+
+func (x *Patient) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Patient) GetPlain() *Plain {
+	if x != nil {
+		return x.Plain
+	}
+	return nil
+}
+
+func (x *Patient) GetDiagnosis() string {
+	if x != nil {
+		return x.Diagnosis
+	}
+	return ""
+}
+
+type Visit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date   string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Doctor string `protobuf:"bytes,2,opt,name=doctor,proto3" json:"doctor,omitempty"`
+}
+
+func (x *Visit) Reset() {
+	*x = Visit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_pii_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Visit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Visit) ProtoMessage() {}
+
+func (x *Visit) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_pii_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Visit.ProtoReflect.Descriptor instead.
+func (*Visit) Descriptor() ([]byte, []int) {
+	return file_testproto_pii_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Visit) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Visit) GetDoctor() string {
+	if x != nil {
+		return x.Doctor
+	}
+	return ""
+}
+
+var File_testproto_pii_proto protoreflect.FileDescriptor
+
+var file_testproto_pii_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x69, 0x69, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x70, 0x69, 0x69, 0x1a, 0x19, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x81, 0x03, 0x0a, 0x07, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x88, 0x4b, 0x01, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x70, 0x69, 0x69, 0x2e, 0x50, 0x61, 0x74, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x4e, 0x6f, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x37, 0x0a,
+	0x09, 0x6c, 0x61, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x69, 0x69,
+	0x2e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x42, 0x03, 0x88, 0x4b, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x34, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x69, 0x69, 0x2e, 0x56, 0x69, 0x73, 0x69, 0x74,
+	0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02,
+	0x10, 0x02, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x6c, 0x61,
+	0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x42, 0x03, 0x88, 0x4b, 0x01, 0x52,
+	0x05, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x09, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x69, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x88, 0x4b, 0x00, 0x52, 0x09,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x1a, 0x38, 0x0a, 0x0a, 0x4e, 0x6f, 0x74,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x05, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x88, 0x4b, 0x01, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x6f, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x03, 0x82,
+	0x4b, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testproto_pii_proto_rawDescOnce sync.Once
+	file_testproto_pii_proto_rawDescData = file_testproto_pii_proto_rawDesc
+)
+
+func file_testproto_pii_proto_rawDescGZIP() []byte {
+	file_testproto_pii_proto_rawDescOnce.Do(func() {
+		file_testproto_pii_proto_rawDescData = protoimpl.X.CompressGZIP(file_testproto_pii_proto_rawDescData)
+	})
+	return file_testproto_pii_proto_rawDescData
+}
+
+var file_testproto_pii_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testproto_pii_proto_goTypes = []interface{}{
+	(*Patient)(nil), // 0: testproto.pii.Patient
+	(*Visit)(nil),   // 1: testproto.pii.Visit
+	nil,             // 2: testproto.pii.Patient.NotesEntry
+	(*Plain)(nil),   // 3: testproto.Plain
+}
+var file_testproto_pii_proto_depIdxs = []int32{
+	2, // 0: testproto.pii.Patient.notes:type_name -> testproto.pii.Patient.NotesEntry
+	1, // 1: testproto.pii.Patient.lastVisit:type_name -> testproto.pii.Visit
+	1, // 2: testproto.pii.Patient.firstVisit:type_name -> testproto.pii.Visit
+	3, // 3: testproto.pii.Patient.plain:type_name -> testproto.Plain
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_testproto_pii_proto_init() }
+func file_testproto_pii_proto_init() {
+	if File_testproto_pii_proto != nil {
+		return
+	}
+	file_testproto_testproto_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_testproto_pii_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Patient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_pii_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Visit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testproto_pii_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testproto_pii_proto_goTypes,
+		DependencyIndexes: file_testproto_pii_proto_depIdxs,
+		MessageInfos:      file_testproto_pii_proto_msgTypes,
+	}.Build()
+	File_testproto_pii_proto = out.File
+	file_testproto_pii_proto_rawDesc = nil
+	file_testproto_pii_proto_goTypes = nil
+	file_testproto_pii_proto_depIdxs = nil
+}
@@ -8,6 +8,7 @@ package testproto
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	_ "github.com/yonesko/protoredact/protoredactpb"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
@@ -72,13 +73,177 @@ func (Enum1) EnumDescriptor() ([]byte, []int) {
 	return file_testproto_testproto_proto_rawDescGZIP(), []int{0}
 }
 
+type Diagnosis int32
+
+const (
+	Diagnosis_DIAGNOSIS_UNSPECIFIED Diagnosis = 0
+	Diagnosis_DIAGNOSIS_FLU         Diagnosis = 1
+	Diagnosis_DIAGNOSIS_HIV         Diagnosis = 2
+	Diagnosis_DIAGNOSIS_REDACTED    Diagnosis = 3
+)
+
+// Enum value maps for Diagnosis.
+var (
+	Diagnosis_name = map[int32]string{
+		0: "DIAGNOSIS_UNSPECIFIED",
+		1: "DIAGNOSIS_FLU",
+		2: "DIAGNOSIS_HIV",
+		3: "DIAGNOSIS_REDACTED",
+	}
+	Diagnosis_value = map[string]int32{
+		"DIAGNOSIS_UNSPECIFIED": 0,
+		"DIAGNOSIS_FLU":         1,
+		"DIAGNOSIS_HIV":         2,
+		"DIAGNOSIS_REDACTED":    3,
+	}
+)
+
+func (x Diagnosis) Enum() *Diagnosis {
+	p := new(Diagnosis)
+	*p = x
+	return p
+}
+
+func (x Diagnosis) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Diagnosis) Descriptor() protoreflect.EnumDescriptor {
+	return file_testproto_testproto_proto_enumTypes[1].Descriptor()
+}
+
+func (Diagnosis) Type() protoreflect.EnumType {
+	return &file_testproto_testproto_proto_enumTypes[1]
+}
+
+func (x Diagnosis) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Diagnosis.Descriptor instead.
+func (Diagnosis) EnumDescriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{1}
+}
+
+type Format int32
+
+const (
+	Format_FORMAT_UNSPECIFIED Format = 0
+	Format_FORMAT_CARD        Format = 1
+	Format_FORMAT_EMAIL       Format = 2
+	Format_FORMAT_PHONE       Format = 3
+	Format_FORMAT_IBAN        Format = 4
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "FORMAT_CARD",
+		2: "FORMAT_EMAIL",
+		3: "FORMAT_PHONE",
+		4: "FORMAT_IBAN",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"FORMAT_CARD":        1,
+		"FORMAT_EMAIL":       2,
+		"FORMAT_PHONE":       3,
+		"FORMAT_IBAN":        4,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_testproto_testproto_proto_enumTypes[2].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_testproto_testproto_proto_enumTypes[2]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{2}
+}
+
+type Strategy int32
+
+const (
+	Strategy_STRATEGY_UNSPECIFIED Strategy = 0
+	Strategy_STRATEGY_CLEAR       Strategy = 1
+	Strategy_STRATEGY_MASK        Strategy = 2
+	Strategy_STRATEGY_HASH        Strategy = 3
+	Strategy_STRATEGY_PLACEHOLDER Strategy = 4
+	Strategy_STRATEGY_KEEP_LAST_N Strategy = 5
+)
+
+// Enum value maps for Strategy.
+var (
+	Strategy_name = map[int32]string{
+		0: "STRATEGY_UNSPECIFIED",
+		1: "STRATEGY_CLEAR",
+		2: "STRATEGY_MASK",
+		3: "STRATEGY_HASH",
+		4: "STRATEGY_PLACEHOLDER",
+		5: "STRATEGY_KEEP_LAST_N",
+	}
+	Strategy_value = map[string]int32{
+		"STRATEGY_UNSPECIFIED": 0,
+		"STRATEGY_CLEAR":       1,
+		"STRATEGY_MASK":        2,
+		"STRATEGY_HASH":        3,
+		"STRATEGY_PLACEHOLDER": 4,
+		"STRATEGY_KEEP_LAST_N": 5,
+	}
+)
+
+func (x Strategy) Enum() *Strategy {
+	p := new(Strategy)
+	*p = x
+	return p
+}
+
+func (x Strategy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Strategy) Descriptor() protoreflect.EnumDescriptor {
+	return file_testproto_testproto_proto_enumTypes[3].Descriptor()
+}
+
+func (Strategy) Type() protoreflect.EnumType {
+	return &file_testproto_testproto_proto_enumTypes[3]
+}
+
+func (x Strategy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Strategy.Descriptor instead.
+func (Strategy) EnumDescriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{3}
+}
+
 type WithAllFieldTypes struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to PaymentToken:
-	//
 	//	*WithAllFieldTypes_Token
 	//	*WithAllFieldTypes_Cryptogram
 	PaymentToken         isWithAllFieldTypes_PaymentToken       `protobuf_oneof:"payment_token"`
@@ -91,6 +256,7 @@ type WithAllFieldTypes struct {
 	Enum1                Enum1                                  `protobuf:"varint,6,opt,name=enum1,proto3,enum=testproto.Enum1" json:"enum1,omitempty"`
 	Enum1Sensitive       Enum1                                  `protobuf:"varint,63,opt,name=enum1Sensitive,proto3,enum=testproto.Enum1" json:"enum1Sensitive,omitempty"`
 	MapField             map[string]*WithAllFieldTypes_Internal `protobuf:"bytes,45,rep,name=mapField,proto3" json:"mapField,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PlainList            []*Plain                               `protobuf:"bytes,7,rep,name=plainList,proto3" json:"plainList,omitempty"`
 }
 
 func (x *WithAllFieldTypes) Reset() {
@@ -209,6 +375,13 @@ func (x *WithAllFieldTypes) GetMapField() map[string]*WithAllFieldTypes_Internal
 	return nil
 }
 
+func (x *WithAllFieldTypes) GetPlainList() []*Plain {
+	if x != nil {
+		return x.PlainList
+	}
+	return nil
+}
+
 type isWithAllFieldTypes_PaymentToken interface {
 	isWithAllFieldTypes_PaymentToken()
 }
@@ -225,17 +398,18 @@ func (*WithAllFieldTypes_Token) isWithAllFieldTypes_PaymentToken() {}
 
 func (*WithAllFieldTypes_Cryptogram) isWithAllFieldTypes_PaymentToken() {}
 
-type SensitiveData struct {
+type Plain struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// if set, hides only specified keys, otherwise the whole field
-	MapKeysToRedact []string `protobuf:"bytes,1,rep,name=map_keys_to_redact,json=mapKeysToRedact,proto3" json:"map_keys_to_redact,omitempty"`
+	FieldInt64  int64  `protobuf:"varint,1,opt,name=fieldInt64,proto3" json:"fieldInt64,omitempty"`
+	FieldString string `protobuf:"bytes,2,opt,name=fieldString,proto3" json:"fieldString,omitempty"`
+	Recursive   *Plain `protobuf:"bytes,3,opt,name=recursive,proto3" json:"recursive,omitempty"`
 }
 
-func (x *SensitiveData) Reset() {
-	*x = SensitiveData{}
+func (x *Plain) Reset() {
+	*x = Plain{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_testproto_testproto_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,13 +417,13 @@ func (x *SensitiveData) Reset() {
 	}
 }
 
-func (x *SensitiveData) String() string {
+func (x *Plain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SensitiveData) ProtoMessage() {}
+func (*Plain) ProtoMessage() {}
 
-func (x *SensitiveData) ProtoReflect() protoreflect.Message {
+func (x *Plain) ProtoReflect() protoreflect.Message {
 	mi := &file_testproto_testproto_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -261,35 +435,52 @@ func (x *SensitiveData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SensitiveData.ProtoReflect.Descriptor instead.
-func (*SensitiveData) Descriptor() ([]byte, []int) {
+// Deprecated: Use Plain.ProtoReflect.Descriptor instead.
+func (*Plain) Descriptor() ([]byte, []int) {
 	return file_testproto_testproto_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *SensitiveData) GetMapKeysToRedact() []string {
+func (x *Plain) GetFieldInt64() int64 {
 	if x != nil {
-		return x.MapKeysToRedact
+		return x.FieldInt64
+	}
+	return 0
+}
+
+func (x *Plain) GetFieldString() string {
+	if x != nil {
+		return x.FieldString
+	}
+	return ""
+}
+
+func (x *Plain) GetRecursive() *Plain {
+	if x != nil {
+		return x.Recursive
 	}
 	return nil
 }
 
-type WithAllFieldTypes_Internal struct {
+type Scalars struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FieldInt64                int64                                  `protobuf:"varint,1,opt,name=fieldInt64,proto3" json:"fieldInt64,omitempty"`
-	FieldStringSensitive      string                                 `protobuf:"bytes,2,opt,name=fieldStringSensitive,proto3" json:"fieldStringSensitive,omitempty"`
-	FieldIntSensitive         int64                                  `protobuf:"varint,26,opt,name=fieldIntSensitive,proto3" json:"fieldIntSensitive,omitempty"`
-	SensitiveMap              map[string]*WithAllFieldTypes_Internal `protobuf:"bytes,45,rep,name=sensitiveMap,proto3" json:"sensitiveMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapWithSensitiveKey       map[string]*WithAllFieldTypes_Internal `protobuf:"bytes,445,rep,name=mapWithSensitiveKey,proto3" json:"mapWithSensitiveKey,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	MapWithSensitiveKeyIntKey map[int64]*WithAllFieldTypes_Internal  `protobuf:"bytes,63,rep,name=mapWithSensitiveKeyIntKey,proto3" json:"mapWithSensitiveKeyIntKey,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Recursive                 *WithAllFieldTypes_Internal            `protobuf:"bytes,23,opt,name=recursive,proto3" json:"recursive,omitempty"`
-	RecursiveSensitive        *WithAllFieldTypes_Internal            `protobuf:"bytes,21,opt,name=recursiveSensitive,proto3" json:"recursiveSensitive,omitempty"`
+	FieldString      string            `protobuf:"bytes,1,opt,name=fieldString,proto3" json:"fieldString,omitempty"`
+	FieldBytes       []byte            `protobuf:"bytes,2,opt,name=fieldBytes,proto3" json:"fieldBytes,omitempty"`
+	FieldInt64       int64             `protobuf:"varint,3,opt,name=fieldInt64,proto3" json:"fieldInt64,omitempty"`
+	FieldUint32      uint32            `protobuf:"varint,4,opt,name=fieldUint32,proto3" json:"fieldUint32,omitempty"`
+	FieldDouble      float64           `protobuf:"fixed64,5,opt,name=fieldDouble,proto3" json:"fieldDouble,omitempty"`
+	FieldBool        bool              `protobuf:"varint,6,opt,name=fieldBool,proto3" json:"fieldBool,omitempty"`
+	Enum1            Enum1             `protobuf:"varint,7,opt,name=enum1,proto3,enum=testproto.Enum1" json:"enum1,omitempty"`
+	StringList       []string          `protobuf:"bytes,8,rep,name=stringList,proto3" json:"stringList,omitempty"`
+	StringMap        map[string]string `protobuf:"bytes,9,rep,name=stringMap,proto3" json:"stringMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Headers          map[string]string `protobuf:"bytes,10,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FieldStringPlain string            `protobuf:"bytes,11,opt,name=fieldStringPlain,proto3" json:"fieldStringPlain,omitempty"`
 }
 
-func (x *WithAllFieldTypes_Internal) Reset() {
-	*x = WithAllFieldTypes_Internal{}
+func (x *Scalars) Reset() {
+	*x = Scalars{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_testproto_testproto_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -297,13 +488,13 @@ func (x *WithAllFieldTypes_Internal) Reset() {
 	}
 }
 
-func (x *WithAllFieldTypes_Internal) String() string {
+func (x *Scalars) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WithAllFieldTypes_Internal) ProtoMessage() {}
+func (*Scalars) ProtoMessage() {}
 
-func (x *WithAllFieldTypes_Internal) ProtoReflect() protoreflect.Message {
+func (x *Scalars) ProtoReflect() protoreflect.Message {
 	mi := &file_testproto_testproto_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -315,298 +506,2228 @@ func (x *WithAllFieldTypes_Internal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WithAllFieldTypes_Internal.ProtoReflect.Descriptor instead.
-func (*WithAllFieldTypes_Internal) Descriptor() ([]byte, []int) {
-	return file_testproto_testproto_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use Scalars.ProtoReflect.Descriptor instead.
+func (*Scalars) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *WithAllFieldTypes_Internal) GetFieldInt64() int64 {
+func (x *Scalars) GetFieldString() string {
+	if x != nil {
+		return x.FieldString
+	}
+	return ""
+}
+
+func (x *Scalars) GetFieldBytes() []byte {
+	if x != nil {
+		return x.FieldBytes
+	}
+	return nil
+}
+
+func (x *Scalars) GetFieldInt64() int64 {
 	if x != nil {
 		return x.FieldInt64
 	}
 	return 0
 }
 
-func (x *WithAllFieldTypes_Internal) GetFieldStringSensitive() string {
+func (x *Scalars) GetFieldUint32() uint32 {
 	if x != nil {
-		return x.FieldStringSensitive
+		return x.FieldUint32
 	}
-	return ""
+	return 0
 }
 
-func (x *WithAllFieldTypes_Internal) GetFieldIntSensitive() int64 {
+func (x *Scalars) GetFieldDouble() float64 {
 	if x != nil {
-		return x.FieldIntSensitive
+		return x.FieldDouble
 	}
 	return 0
 }
 
-func (x *WithAllFieldTypes_Internal) GetSensitiveMap() map[string]*WithAllFieldTypes_Internal {
+func (x *Scalars) GetFieldBool() bool {
 	if x != nil {
-		return x.SensitiveMap
+		return x.FieldBool
 	}
-	return nil
+	return false
 }
 
-func (x *WithAllFieldTypes_Internal) GetMapWithSensitiveKey() map[string]*WithAllFieldTypes_Internal {
+func (x *Scalars) GetEnum1() Enum1 {
 	if x != nil {
-		return x.MapWithSensitiveKey
+		return x.Enum1
 	}
-	return nil
+	return Enum1_UNSPECIFIED
 }
 
-func (x *WithAllFieldTypes_Internal) GetMapWithSensitiveKeyIntKey() map[int64]*WithAllFieldTypes_Internal {
+func (x *Scalars) GetStringList() []string {
 	if x != nil {
-		return x.MapWithSensitiveKeyIntKey
+		return x.StringList
 	}
 	return nil
 }
 
-func (x *WithAllFieldTypes_Internal) GetRecursive() *WithAllFieldTypes_Internal {
+func (x *Scalars) GetStringMap() map[string]string {
 	if x != nil {
-		return x.Recursive
+		return x.StringMap
 	}
 	return nil
 }
 
-func (x *WithAllFieldTypes_Internal) GetRecursiveSensitive() *WithAllFieldTypes_Internal {
+func (x *Scalars) GetHeaders() map[string]string {
 	if x != nil {
-		return x.RecursiveSensitive
+		return x.Headers
 	}
 	return nil
 }
 
-var file_testproto_testproto_proto_extTypes = []protoimpl.ExtensionInfo{
-	{
-		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
-		ExtensionType: (*SensitiveData)(nil),
-		Field:         1200,
-		Name:          "testproto.sensitive_data",
-		Tag:           "bytes,1200,opt,name=sensitive_data",
-		Filename:      "testproto/testproto.proto",
-	},
+func (x *Scalars) GetFieldStringPlain() string {
+	if x != nil {
+		return x.FieldStringPlain
+	}
+	return ""
 }
 
-// Extension fields to descriptorpb.FieldOptions.
-var (
-	// optional testproto.SensitiveData sensitive_data = 1200;
-	E_SensitiveData = &file_testproto_testproto_proto_extTypes[0]
-)
+type Contacts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var File_testproto_testproto_proto protoreflect.FileDescriptor
+	Card   string   `protobuf:"bytes,1,opt,name=card,proto3" json:"card,omitempty"`
+	Email  string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Phone  string   `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Iban   string   `protobuf:"bytes,4,opt,name=iban,proto3" json:"iban,omitempty"`
+	Name   string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Emails []string `protobuf:"bytes,6,rep,name=emails,proto3" json:"emails,omitempty"`
+}
 
-var file_testproto_testproto_proto_rawDesc = []byte{
-	0x0a, 0x19, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74, 0x65, 0x73,
-	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x0d, 0x0a, 0x11, 0x57, 0x69, 0x74,
-	0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16,
-	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f,
-	0x67, 0x72, 0x61, 0x6d, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x48,
-	0x00, 0x52, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x0a,
-	0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x33, 0x0a,
-	0x12, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x12, 0x66, 0x69, 0x65,
-	0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x88,
-	0x01, 0x01, 0x12, 0x37, 0x0a, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x47, 0x0a, 0x0b, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41,
-	0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x5e, 0x0a, 0x14, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74,
-	0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x14, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
-	0x76, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e,
-	0x75, 0x6d, 0x31, 0x52, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x3d, 0x0a, 0x0e, 0x65, 0x6e,
-	0x75, 0x6d, 0x31, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x3f, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45,
-	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0e, 0x65, 0x6e, 0x75, 0x6d, 0x31,
-	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x6d, 0x61, 0x70,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x1a, 0xfe, 0x07, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1e,
-	0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x37,
-	0x0a, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b,
-	0x00, 0x52, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65,
-	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x31, 0x0a, 0x11, 0x66, 0x69, 0x65, 0x6c, 0x64,
-	0x49, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x1a, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x11, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e,
-	0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x60, 0x0a, 0x0c, 0x73, 0x65,
-	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x37, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74,
-	0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0c,
-	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x85, 0x01, 0x0a,
-	0x13, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x4b, 0x65, 0x79, 0x18, 0xbd, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0x4b, 0x0f,
-	0x0a, 0x0d, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x52,
-	0x13, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x4b, 0x65, 0x79, 0x12, 0x8e, 0x01, 0x0a, 0x19, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68,
-	0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x4b,
-	0x65, 0x79, 0x18, 0x3f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
-	0x4d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a,
-	0x82, 0x4b, 0x07, 0x0a, 0x05, 0x38, 0x37, 0x36, 0x35, 0x34, 0x52, 0x19, 0x6d, 0x61, 0x70, 0x57,
-	0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x49,
-	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
-	0x76, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52,
-	0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x72, 0x65,
-	0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
-	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x03, 0x82,
-	0x4b, 0x00, 0x52, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x1a, 0x66, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
-	0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c,
-	0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x6e, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d,
-	0x0a, 0x18, 0x4d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
-	0x76, 0x65, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65,
-	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46,
-	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x73, 0x0a,
-	0x1e, 0x4d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
-	0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74,
-	0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x62, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0f, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
-	0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x3c,
-	0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x2b, 0x0a, 0x12, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72,
-	0x65, 0x64, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x70,
-	0x4b, 0x65, 0x79, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x2a, 0x2a, 0x0a, 0x05,
-	0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
-	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x31,
-	0x5f, 0x56, 0x41, 0x4c, 0x5f, 0x31, 0x10, 0x01, 0x3a, 0x5f, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x73,
-	0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb0, 0x09, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e,
-	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x73,
-	0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+func (x *Contacts) Reset() {
+	*x = Contacts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-var (
-	file_testproto_testproto_proto_rawDescOnce sync.Once
-	file_testproto_testproto_proto_rawDescData = file_testproto_testproto_proto_rawDesc
-)
+func (x *Contacts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_testproto_testproto_proto_rawDescGZIP() []byte {
-	file_testproto_testproto_proto_rawDescOnce.Do(func() {
-		file_testproto_testproto_proto_rawDescData = protoimpl.X.CompressGZIP(file_testproto_testproto_proto_rawDescData)
-	})
-	return file_testproto_testproto_proto_rawDescData
+func (*Contacts) ProtoMessage() {}
+
+func (x *Contacts) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_testproto_testproto_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_testproto_testproto_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-var file_testproto_testproto_proto_goTypes = []interface{}{
-	(Enum1)(0),                         // 0: testproto.Enum1
-	(*WithAllFieldTypes)(nil),          // 1: testproto.WithAllFieldTypes
-	(*SensitiveData)(nil),              // 2: testproto.SensitiveData
-	(*WithAllFieldTypes_Internal)(nil), // 3: testproto.WithAllFieldTypes.Internal
-	nil,                                // 4: testproto.WithAllFieldTypes.MapFieldEntry
-	nil,                                // 5: testproto.WithAllFieldTypes.Internal.SensitiveMapEntry
-	nil,                                // 6: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyEntry
-	nil,                                // 7: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyIntKeyEntry
-	(*descriptorpb.FieldOptions)(nil),  // 8: google.protobuf.FieldOptions
+// Deprecated: Use Contacts.ProtoReflect.Descriptor instead.
+func (*Contacts) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{3}
 }
-var file_testproto_testproto_proto_depIdxs = []int32{
-	3,  // 0: testproto.WithAllFieldTypes.messageList:type_name -> testproto.WithAllFieldTypes.Internal
-	3,  // 1: testproto.WithAllFieldTypes.messageListSensitive:type_name -> testproto.WithAllFieldTypes.Internal
-	0,  // 2: testproto.WithAllFieldTypes.enum1:type_name -> testproto.Enum1
-	0,  // 3: testproto.WithAllFieldTypes.enum1Sensitive:type_name -> testproto.Enum1
-	4,  // 4: testproto.WithAllFieldTypes.mapField:type_name -> testproto.WithAllFieldTypes.MapFieldEntry
-	5,  // 5: testproto.WithAllFieldTypes.Internal.sensitiveMap:type_name -> testproto.WithAllFieldTypes.Internal.SensitiveMapEntry
-	6,  // 6: testproto.WithAllFieldTypes.Internal.mapWithSensitiveKey:type_name -> testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyEntry
-	7,  // 7: testproto.WithAllFieldTypes.Internal.mapWithSensitiveKeyIntKey:type_name -> testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyIntKeyEntry
-	3,  // 8: testproto.WithAllFieldTypes.Internal.recursive:type_name -> testproto.WithAllFieldTypes.Internal
-	3,  // 9: testproto.WithAllFieldTypes.Internal.recursiveSensitive:type_name -> testproto.WithAllFieldTypes.Internal
-	3,  // 10: testproto.WithAllFieldTypes.MapFieldEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
-	3,  // 11: testproto.WithAllFieldTypes.Internal.SensitiveMapEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
-	3,  // 12: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
-	3,  // 13: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyIntKeyEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
-	8,  // 14: testproto.sensitive_data:extendee -> google.protobuf.FieldOptions
-	2,  // 15: testproto.sensitive_data:type_name -> testproto.SensitiveData
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	15, // [15:16] is the sub-list for extension type_name
-	14, // [14:15] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+
+func (x *Contacts) GetCard() string {
+	if x != nil {
+		return x.Card
+	}
+	return ""
 }
 
-func init() { file_testproto_testproto_proto_init() }
-func file_testproto_testproto_proto_init() {
-	if File_testproto_testproto_proto != nil {
-		return
+func (x *Contacts) GetEmail() string {
+	if x != nil {
+		return x.Email
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_testproto_testproto_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WithAllFieldTypes); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_testproto_testproto_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SensitiveData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
+	return ""
+}
+
+func (x *Contacts) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Contacts) GetIban() string {
+	if x != nil {
+		return x.Iban
+	}
+	return ""
+}
+
+func (x *Contacts) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Contacts) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+type Treatments struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cleared     string   `protobuf:"bytes,1,opt,name=cleared,proto3" json:"cleared,omitempty"`
+	Masked      string   `protobuf:"bytes,2,opt,name=masked,proto3" json:"masked,omitempty"`
+	Hashed      string   `protobuf:"bytes,3,opt,name=hashed,proto3" json:"hashed,omitempty"`
+	Placeholder string   `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	LastFour    string   `protobuf:"bytes,5,opt,name=lastFour,proto3" json:"lastFour,omitempty"`
+	Unspecified string   `protobuf:"bytes,6,opt,name=unspecified,proto3" json:"unspecified,omitempty"`
+	MaskedList  []string `protobuf:"bytes,7,rep,name=maskedList,proto3" json:"maskedList,omitempty"`
+	HashedInt64 int64    `protobuf:"varint,8,opt,name=hashedInt64,proto3" json:"hashedInt64,omitempty"`
+}
+
+func (x *Treatments) Reset() {
+	*x = Treatments{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Treatments) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Treatments) ProtoMessage() {}
+
+func (x *Treatments) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Treatments.ProtoReflect.Descriptor instead.
+func (*Treatments) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Treatments) GetCleared() string {
+	if x != nil {
+		return x.Cleared
+	}
+	return ""
+}
+
+func (x *Treatments) GetMasked() string {
+	if x != nil {
+		return x.Masked
+	}
+	return ""
+}
+
+func (x *Treatments) GetHashed() string {
+	if x != nil {
+		return x.Hashed
+	}
+	return ""
+}
+
+func (x *Treatments) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *Treatments) GetLastFour() string {
+	if x != nil {
+		return x.LastFour
+	}
+	return ""
+}
+
+func (x *Treatments) GetUnspecified() string {
+	if x != nil {
+		return x.Unspecified
+	}
+	return ""
+}
+
+func (x *Treatments) GetMaskedList() []string {
+	if x != nil {
+		return x.MaskedList
+	}
+	return nil
+}
+
+func (x *Treatments) GetHashedInt64() int64 {
+	if x != nil {
+		return x.HashedInt64
+	}
+	return 0
+}
+
+type Canonical struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldStringSensitive string            `protobuf:"bytes,1,opt,name=fieldStringSensitive,proto3" json:"fieldStringSensitive,omitempty"`
+	Headers              map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FieldString          string            `protobuf:"bytes,3,opt,name=fieldString,proto3" json:"fieldString,omitempty"`
+	Masked               string            `protobuf:"bytes,4,opt,name=masked,proto3" json:"masked,omitempty"`
+	Email                string            `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *Canonical) Reset() {
+	*x = Canonical{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Canonical) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Canonical) ProtoMessage() {}
+
+func (x *Canonical) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Canonical.ProtoReflect.Descriptor instead.
+func (*Canonical) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Canonical) GetFieldStringSensitive() string {
+	if x != nil {
+		return x.FieldStringSensitive
+	}
+	return ""
+}
+
+func (x *Canonical) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Canonical) GetFieldString() string {
+	if x != nil {
+		return x.FieldString
+	}
+	return ""
+}
+
+func (x *Canonical) GetMasked() string {
+	if x != nil {
+		return x.Masked
+	}
+	return ""
+}
+
+func (x *Canonical) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CardDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Number string `protobuf:"bytes,1,opt,name=number,proto3" json:"number,omitempty"`
+	Holder string `protobuf:"bytes,2,opt,name=holder,proto3" json:"holder,omitempty"`
+}
+
+func (x *CardDetails) Reset() {
+	*x = CardDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CardDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CardDetails) ProtoMessage() {}
+
+func (x *CardDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CardDetails.ProtoReflect.Descriptor instead.
+func (*CardDetails) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CardDetails) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
+}
+
+func (x *CardDetails) GetHolder() string {
+	if x != nil {
+		return x.Holder
+	}
+	return ""
+}
+
+type Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Card        *CardDetails            `protobuf:"bytes,2,opt,name=card,proto3" json:"card,omitempty"`
+	Cards       []*CardDetails          `protobuf:"bytes,3,rep,name=cards,proto3" json:"cards,omitempty"`
+	CardsByName map[string]*CardDetails `protobuf:"bytes,4,rep,name=cardsByName,proto3" json:"cardsByName,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaskedCard  *CardDetails            `protobuf:"bytes,5,opt,name=maskedCard,proto3" json:"maskedCard,omitempty"`
+	Parent      *Order                  `protobuf:"bytes,6,opt,name=parent,proto3" json:"parent,omitempty"`
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Order) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Order) GetCard() *CardDetails {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+func (x *Order) GetCards() []*CardDetails {
+	if x != nil {
+		return x.Cards
+	}
+	return nil
+}
+
+func (x *Order) GetCardsByName() map[string]*CardDetails {
+	if x != nil {
+		return x.CardsByName
+	}
+	return nil
+}
+
+func (x *Order) GetMaskedCard() *CardDetails {
+	if x != nil {
+		return x.MaskedCard
+	}
+	return nil
+}
+
+func (x *Order) GetParent() *Order {
+	if x != nil {
+		return x.Parent
+	}
+	return nil
+}
+
+type Event struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserEmail string            `protobuf:"bytes,2,opt,name=userEmail,proto3" json:"userEmail,omitempty"`
+	Amount    int64             `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Tags      []string          `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Labels    map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Plains    map[string]*Plain `protobuf:"bytes,6,rep,name=plains,proto3" json:"plains,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Types that are assignable to Subject:
+	//	*Event_UserId
+	//	*Event_PlainSubject
+	Subject isEvent_Subject `protobuf_oneof:"subject"`
+	Kept    *Plain          `protobuf:"bytes,9,opt,name=kept,proto3" json:"kept,omitempty"`
+	Kind    Enum1           `protobuf:"varint,10,opt,name=kind,proto3,enum=testproto.Enum1" json:"kind,omitempty"`
+	Secret  string          `protobuf:"bytes,11,opt,name=secret,proto3" json:"secret,omitempty"`
+	Card    *CardDetails    `protobuf:"bytes,12,opt,name=card,proto3" json:"card,omitempty"`
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Event) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Event) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *Event) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Event) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Event) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Event) GetPlains() map[string]*Plain {
+	if x != nil {
+		return x.Plains
+	}
+	return nil
+}
+
+func (m *Event) GetSubject() isEvent_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *Event) GetUserId() string {
+	if x, ok := x.GetSubject().(*Event_UserId); ok {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Event) GetPlainSubject() *Plain {
+	if x, ok := x.GetSubject().(*Event_PlainSubject); ok {
+		return x.PlainSubject
+	}
+	return nil
+}
+
+func (x *Event) GetKept() *Plain {
+	if x != nil {
+		return x.Kept
+	}
+	return nil
+}
+
+func (x *Event) GetKind() Enum1 {
+	if x != nil {
+		return x.Kind
+	}
+	return Enum1_UNSPECIFIED
+}
+
+func (x *Event) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Event) GetCard() *CardDetails {
+	if x != nil {
+		return x.Card
+	}
+	return nil
+}
+
+type isEvent_Subject interface {
+	isEvent_Subject()
+}
+
+type Event_UserId struct {
+	UserId string `protobuf:"bytes,7,opt,name=userId,proto3,oneof"`
+}
+
+type Event_PlainSubject struct {
+	PlainSubject *Plain `protobuf:"bytes,8,opt,name=plainSubject,proto3,oneof"`
+}
+
+func (*Event_UserId) isEvent_Subject() {}
+
+func (*Event_PlainSubject) isEvent_Subject() {}
+
+type MedicalRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Diagnosis Diagnosis            `protobuf:"varint,1,opt,name=diagnosis,proto3,enum=testproto.Diagnosis" json:"diagnosis,omitempty"`
+	History   []Diagnosis          `protobuf:"varint,2,rep,packed,name=history,proto3,enum=testproto.Diagnosis" json:"history,omitempty"`
+	ByVisit   map[string]Diagnosis `protobuf:"bytes,3,rep,name=byVisit,proto3" json:"byVisit,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=testproto.Diagnosis"`
+	Related   []*MedicalRecord     `protobuf:"bytes,4,rep,name=related,proto3" json:"related,omitempty"`
+}
+
+func (x *MedicalRecord) Reset() {
+	*x = MedicalRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MedicalRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MedicalRecord) ProtoMessage() {}
+
+func (x *MedicalRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MedicalRecord.ProtoReflect.Descriptor instead.
+func (*MedicalRecord) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MedicalRecord) GetDiagnosis() Diagnosis {
+	if x != nil {
+		return x.Diagnosis
+	}
+	return Diagnosis_DIAGNOSIS_UNSPECIFIED
+}
+
+func (x *MedicalRecord) GetHistory() []Diagnosis {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetByVisit() map[string]Diagnosis {
+	if x != nil {
+		return x.ByVisit
+	}
+	return nil
+}
+
+func (x *MedicalRecord) GetRelated() []*MedicalRecord {
+	if x != nil {
+		return x.Related
+	}
+	return nil
+}
+
+type Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are assignable to Method:
+	//	*Payment_Pan
+	//	*Payment_Details
+	//	*Payment_Cryptogram
+	Method isPayment_Method `protobuf_oneof:"method"`
+}
+
+func (x *Payment) Reset() {
+	*x = Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Payment) ProtoMessage() {}
+
+func (x *Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Payment.ProtoReflect.Descriptor instead.
+func (*Payment) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Payment) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (m *Payment) GetMethod() isPayment_Method {
+	if m != nil {
+		return m.Method
+	}
+	return nil
+}
+
+func (x *Payment) GetPan() string {
+	if x, ok := x.GetMethod().(*Payment_Pan); ok {
+		return x.Pan
+	}
+	return ""
+}
+
+func (x *Payment) GetDetails() *Plain {
+	if x, ok := x.GetMethod().(*Payment_Details); ok {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Payment) GetCryptogram() string {
+	if x, ok := x.GetMethod().(*Payment_Cryptogram); ok {
+		return x.Cryptogram
+	}
+	return ""
+}
+
+type isPayment_Method interface {
+	isPayment_Method()
+}
+
+type Payment_Pan struct {
+	Pan string `protobuf:"bytes,2,opt,name=pan,proto3,oneof"`
+}
+
+type Payment_Details struct {
+	Details *Plain `protobuf:"bytes,3,opt,name=details,proto3,oneof"`
+}
+
+type Payment_Cryptogram struct {
+	Cryptogram string `protobuf:"bytes,4,opt,name=cryptogram,proto3,oneof"`
+}
+
+func (*Payment_Pan) isPayment_Method() {}
+
+func (*Payment_Details) isPayment_Method() {}
+
+func (*Payment_Cryptogram) isPayment_Method() {}
+
+type Accounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ByPattern    map[string]*WithAllFieldTypes_Internal `protobuf:"bytes,1,rep,name=byPattern,proto3" json:"byPattern,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Details      map[string]*Plain                      `protobuf:"bytes,2,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TokenDetails map[string]*Plain                      `protobuf:"bytes,3,rep,name=tokenDetails,proto3" json:"tokenDetails,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Typo         map[string]*Plain                      `protobuf:"bytes,4,rep,name=typo,proto3" json:"typo,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Internals    map[string]*WithAllFieldTypes_Internal `protobuf:"bytes,5,rep,name=internals,proto3" json:"internals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Accounts) Reset() {
+	*x = Accounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Accounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Accounts) ProtoMessage() {}
+
+func (x *Accounts) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Accounts.ProtoReflect.Descriptor instead.
+func (*Accounts) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Accounts) GetByPattern() map[string]*WithAllFieldTypes_Internal {
+	if x != nil {
+		return x.ByPattern
+	}
+	return nil
+}
+
+func (x *Accounts) GetDetails() map[string]*Plain {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Accounts) GetTokenDetails() map[string]*Plain {
+	if x != nil {
+		return x.TokenDetails
+	}
+	return nil
+}
+
+func (x *Accounts) GetTypo() map[string]*Plain {
+	if x != nil {
+		return x.Typo
+	}
+	return nil
+}
+
+func (x *Accounts) GetInternals() map[string]*WithAllFieldTypes_Internal {
+	if x != nil {
+		return x.Internals
+	}
+	return nil
+}
+
+type HttpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cookies map[string]string `protobuf:"bytes,2,rep,name=cookies,proto3" json:"cookies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Broken  map[string]string `protobuf:"bytes,3,rep,name=broken,proto3" json:"broken,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *HttpRequest) Reset() {
+	*x = HttpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HttpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HttpRequest) ProtoMessage() {}
+
+func (x *HttpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HttpRequest.ProtoReflect.Descriptor instead.
+func (*HttpRequest) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *HttpRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *HttpRequest) GetCookies() map[string]string {
+	if x != nil {
+		return x.Cookies
+	}
+	return nil
+}
+
+func (x *HttpRequest) GetBroken() map[string]string {
+	if x != nil {
+		return x.Broken
+	}
+	return nil
+}
+
+type UserStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PerUser    map[string]*Plain `protobuf:"bytes,1,rep,name=perUser,proto3" json:"perUser,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PerContact map[string]int64  `protobuf:"bytes,2,rep,name=perContact,proto3" json:"perContact,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	PerPhone   map[int64]*Plain  `protobuf:"bytes,3,rep,name=perPhone,proto3" json:"perPhone,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *UserStats) Reset() {
+	*x = UserStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserStats) ProtoMessage() {}
+
+func (x *UserStats) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserStats.ProtoReflect.Descriptor instead.
+func (*UserStats) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *UserStats) GetPerUser() map[string]*Plain {
+	if x != nil {
+		return x.PerUser
+	}
+	return nil
+}
+
+func (x *UserStats) GetPerContact() map[string]int64 {
+	if x != nil {
+		return x.PerContact
+	}
+	return nil
+}
+
+func (x *UserStats) GetPerPhone() map[int64]*Plain {
+	if x != nil {
+		return x.PerPhone
+	}
+	return nil
+}
+
+type SensitiveData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//if set, hides only specified keys, otherwise the whole field; keys with *, ? or [ are glob patterns, e.g. "*_token",
+	//which match the same key literally too
+	MapKeysToRedact []string `protobuf:"bytes,1,rep,name=map_keys_to_redact,json=mapKeysToRedact,proto3" json:"map_keys_to_redact,omitempty"`
+	//if set, format-preserving handlers keep the shape of the value
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=testproto.Format" json:"format,omitempty"`
+	//if set, handlers.Strategies picks the treatment of the field
+	Strategy Strategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=testproto.Strategy" json:"strategy,omitempty"`
+	//replacement for STRATEGY_PLACEHOLDER
+	Placeholder string `protobuf:"bytes,4,opt,name=placeholder,proto3" json:"placeholder,omitempty"`
+	//number of characters kept by STRATEGY_KEEP_LAST_N
+	KeepLastN uint32 `protobuf:"varint,5,opt,name=keep_last_n,json=keepLastN,proto3" json:"keep_last_n,omitempty"`
+	//STRATEGY_MASK keeps the original length
+	PreserveLength bool `protobuf:"varint,6,opt,name=preserve_length,json=preserveLength,proto3" json:"preserve_length,omitempty"`
+	//for oneofs, the set member is cleared instead of being passed to the handler, so the chosen variant is hidden too,
+	//whatever annotation or safe marker the member has
+	HideVariant bool `protobuf:"varint,7,opt,name=hide_variant,json=hideVariant,proto3" json:"hide_variant,omitempty"`
+	//for maps of messages, hides only these fields of the values, dot separated for nested messages, e.g. "card.number";
+	//applies to entries matching map_keys_to_redact or to all entries
+	MapValueFields []string `protobuf:"bytes,8,rep,name=map_value_fields,json=mapValueFields,proto3" json:"map_value_fields,omitempty"`
+	//RE2 patterns of map keys to hide, matching anywhere in the key unless anchored with ^ and $
+	MapKeyRegexps []string `protobuf:"bytes,9,rep,name=map_key_regexps,json=mapKeyRegexps,proto3" json:"map_key_regexps,omitempty"`
+	//map_keys_to_redact and map_key_regexps ignore case, e.g. for HTTP headers
+	MapKeysCaseInsensitive bool `protobuf:"varint,10,opt,name=map_keys_case_insensitive,json=mapKeysCaseInsensitive,proto3" json:"map_keys_case_insensitive,omitempty"`
+	//keys of matching entries, or of all entries if no keys are listed, are rewritten by Redactor.MapKeyHandler, values are kept
+	RedactMapKeys bool `protobuf:"varint,11,opt,name=redact_map_keys,json=redactMapKeys,proto3" json:"redact_map_keys,omitempty"`
+	//with redact_map_keys, a rewritten key colliding with another one fails redaction instead of merging the values
+	ErrorOnKeyCollision bool `protobuf:"varint,12,opt,name=error_on_key_collision,json=errorOnKeyCollision,proto3" json:"error_on_key_collision,omitempty"`
+}
+
+func (x *SensitiveData) Reset() {
+	*x = SensitiveData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SensitiveData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensitiveData) ProtoMessage() {}
+
+func (x *SensitiveData) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensitiveData.ProtoReflect.Descriptor instead.
+func (*SensitiveData) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SensitiveData) GetMapKeysToRedact() []string {
+	if x != nil {
+		return x.MapKeysToRedact
+	}
+	return nil
+}
+
+func (x *SensitiveData) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *SensitiveData) GetStrategy() Strategy {
+	if x != nil {
+		return x.Strategy
+	}
+	return Strategy_STRATEGY_UNSPECIFIED
+}
+
+func (x *SensitiveData) GetPlaceholder() string {
+	if x != nil {
+		return x.Placeholder
+	}
+	return ""
+}
+
+func (x *SensitiveData) GetKeepLastN() uint32 {
+	if x != nil {
+		return x.KeepLastN
+	}
+	return 0
+}
+
+func (x *SensitiveData) GetPreserveLength() bool {
+	if x != nil {
+		return x.PreserveLength
+	}
+	return false
+}
+
+func (x *SensitiveData) GetHideVariant() bool {
+	if x != nil {
+		return x.HideVariant
+	}
+	return false
+}
+
+func (x *SensitiveData) GetMapValueFields() []string {
+	if x != nil {
+		return x.MapValueFields
+	}
+	return nil
+}
+
+func (x *SensitiveData) GetMapKeyRegexps() []string {
+	if x != nil {
+		return x.MapKeyRegexps
+	}
+	return nil
+}
+
+func (x *SensitiveData) GetMapKeysCaseInsensitive() bool {
+	if x != nil {
+		return x.MapKeysCaseInsensitive
+	}
+	return false
+}
+
+func (x *SensitiveData) GetRedactMapKeys() bool {
+	if x != nil {
+		return x.RedactMapKeys
+	}
+	return false
+}
+
+func (x *SensitiveData) GetErrorOnKeyCollision() bool {
+	if x != nil {
+		return x.ErrorOnKeyCollision
+	}
+	return false
+}
+
+type WithAllFieldTypes_Internal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FieldInt64                int64                                  `protobuf:"varint,1,opt,name=fieldInt64,proto3" json:"fieldInt64,omitempty"`
+	FieldStringSensitive      string                                 `protobuf:"bytes,2,opt,name=fieldStringSensitive,proto3" json:"fieldStringSensitive,omitempty"`
+	FieldIntSensitive         int64                                  `protobuf:"varint,26,opt,name=fieldIntSensitive,proto3" json:"fieldIntSensitive,omitempty"`
+	SensitiveMap              map[string]*WithAllFieldTypes_Internal `protobuf:"bytes,45,rep,name=sensitiveMap,proto3" json:"sensitiveMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapWithSensitiveKey       map[string]*WithAllFieldTypes_Internal `protobuf:"bytes,445,rep,name=mapWithSensitiveKey,proto3" json:"mapWithSensitiveKey,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapWithSensitiveKeyIntKey map[int64]*WithAllFieldTypes_Internal  `protobuf:"bytes,63,rep,name=mapWithSensitiveKeyIntKey,proto3" json:"mapWithSensitiveKeyIntKey,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Recursive                 *WithAllFieldTypes_Internal            `protobuf:"bytes,23,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	RecursiveSensitive        *WithAllFieldTypes_Internal            `protobuf:"bytes,21,opt,name=recursiveSensitive,proto3" json:"recursiveSensitive,omitempty"`
+}
+
+func (x *WithAllFieldTypes_Internal) Reset() {
+	*x = WithAllFieldTypes_Internal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testproto_testproto_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WithAllFieldTypes_Internal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WithAllFieldTypes_Internal) ProtoMessage() {}
+
+func (x *WithAllFieldTypes_Internal) ProtoReflect() protoreflect.Message {
+	mi := &file_testproto_testproto_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WithAllFieldTypes_Internal.ProtoReflect.Descriptor instead.
+func (*WithAllFieldTypes_Internal) Descriptor() ([]byte, []int) {
+	return file_testproto_testproto_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *WithAllFieldTypes_Internal) GetFieldInt64() int64 {
+	if x != nil {
+		return x.FieldInt64
+	}
+	return 0
+}
+
+func (x *WithAllFieldTypes_Internal) GetFieldStringSensitive() string {
+	if x != nil {
+		return x.FieldStringSensitive
+	}
+	return ""
+}
+
+func (x *WithAllFieldTypes_Internal) GetFieldIntSensitive() int64 {
+	if x != nil {
+		return x.FieldIntSensitive
+	}
+	return 0
+}
+
+func (x *WithAllFieldTypes_Internal) GetSensitiveMap() map[string]*WithAllFieldTypes_Internal {
+	if x != nil {
+		return x.SensitiveMap
+	}
+	return nil
+}
+
+func (x *WithAllFieldTypes_Internal) GetMapWithSensitiveKey() map[string]*WithAllFieldTypes_Internal {
+	if x != nil {
+		return x.MapWithSensitiveKey
+	}
+	return nil
+}
+
+func (x *WithAllFieldTypes_Internal) GetMapWithSensitiveKeyIntKey() map[int64]*WithAllFieldTypes_Internal {
+	if x != nil {
+		return x.MapWithSensitiveKeyIntKey
+	}
+	return nil
+}
+
+func (x *WithAllFieldTypes_Internal) GetRecursive() *WithAllFieldTypes_Internal {
+	if x != nil {
+		return x.Recursive
+	}
+	return nil
+}
+
+func (x *WithAllFieldTypes_Internal) GetRecursiveSensitive() *WithAllFieldTypes_Internal {
+	if x != nil {
+		return x.RecursiveSensitive
+	}
+	return nil
+}
+
+var file_testproto_testproto_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1200,
+		Name:          "testproto.sensitive_data",
+		Tag:           "bytes,1200,opt,name=sensitive_data",
+		Filename:      "testproto/testproto.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*bool)(nil),
+		Field:         1201,
+		Name:          "testproto.safe_to_log",
+		Tag:           "varint,1201,opt,name=safe_to_log",
+		Filename:      "testproto/testproto.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1200,
+		Name:          "testproto.sensitive_message",
+		Tag:           "bytes,1200,opt,name=sensitive_message",
+		Filename:      "testproto/testproto.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FileOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1200,
+		Name:          "testproto.sensitive_file",
+		Tag:           "bytes,1200,opt,name=sensitive_file",
+		Filename:      "testproto/testproto.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1200,
+		Name:          "testproto.sensitive_value",
+		Tag:           "bytes,1200,opt,name=sensitive_value",
+		Filename:      "testproto/testproto.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.OneofOptions)(nil),
+		ExtensionType: (*SensitiveData)(nil),
+		Field:         1200,
+		Name:          "testproto.sensitive_oneof",
+		Tag:           "bytes,1200,opt,name=sensitive_oneof",
+		Filename:      "testproto/testproto.proto",
+	},
+}
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional testproto.SensitiveData sensitive_data = 1200;
+	E_SensitiveData = &file_testproto_testproto_proto_extTypes[0]
+	// optional bool safe_to_log = 1201;
+	E_SafeToLog = &file_testproto_testproto_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional testproto.SensitiveData sensitive_message = 1200;
+	E_SensitiveMessage = &file_testproto_testproto_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.FileOptions.
+var (
+	// optional testproto.SensitiveData sensitive_file = 1200;
+	E_SensitiveFile = &file_testproto_testproto_proto_extTypes[3]
+)
+
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// optional testproto.SensitiveData sensitive_value = 1200;
+	E_SensitiveValue = &file_testproto_testproto_proto_extTypes[4]
+)
+
+// Extension fields to descriptorpb.OneofOptions.
+var (
+	// optional testproto.SensitiveData sensitive_oneof = 1200;
+	E_SensitiveOneof = &file_testproto_testproto_proto_extTypes[5]
+)
+
+var File_testproto_testproto_proto protoreflect.FileDescriptor
+
+var file_testproto_testproto_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x72,
+	0x65, 0x64, 0x61, 0x63, 0x74, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x98, 0x0e, 0x0a, 0x11, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x34, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x33, 0x0a, 0x12, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x12, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a,
+	0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00,
+	0x52, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42,
+	0x6f, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x47, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5e, 0x0a,
+	0x14, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x14, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x26, 0x0a,
+	0x05, 0x65, 0x6e, 0x75, 0x6d, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x52, 0x05,
+	0x65, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x3d, 0x0a, 0x0e, 0x65, 0x6e, 0x75, 0x6d, 0x31, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42,
+	0x03, 0x82, 0x4b, 0x00, 0x52, 0x0e, 0x65, 0x6e, 0x75, 0x6d, 0x31, 0x53, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x2e, 0x0a, 0x09,
+	0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69,
+	0x6e, 0x52, 0x09, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0xfe, 0x07, 0x0a,
+	0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x37, 0x0a, 0x14, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x14, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x31, 0x0a, 0x11, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0x82,
+	0x4b, 0x00, 0x52, 0x11, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x53, 0x65, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x60, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x4d, 0x61, 0x70, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0c, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x4d, 0x61, 0x70, 0x12, 0x85, 0x01, 0x0a, 0x13, 0x6d, 0x61, 0x70, 0x57,
+	0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x18,
+	0xbd, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61,
+	0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0x4b, 0x0f, 0x0a, 0x0d, 0x68, 0x69, 0x64,
+	0x65, 0x5f, 0x74, 0x68, 0x69, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x52, 0x13, 0x6d, 0x61, 0x70, 0x57,
+	0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x12,
+	0x8e, 0x01, 0x0a, 0x19, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x3f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x44, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x57, 0x69,
+	0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0a, 0x82, 0x4b, 0x07, 0x0a, 0x05,
+	0x38, 0x37, 0x36, 0x35, 0x34, 0x52, 0x19, 0x6d, 0x61, 0x70, 0x57, 0x69, 0x74, 0x68, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x4b, 0x65, 0x79,
+	0x12, 0x43, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x09, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69,
+	0x76, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69,
+	0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x12, 0x72,
+	0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x1a, 0x66, 0x0a, 0x11, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x61,
+	0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x6d, 0x0a, 0x18, 0x4d, 0x61, 0x70,
+	0x57, 0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x73, 0x0a, 0x1e, 0x4d, 0x61, 0x70, 0x57,
+	0x69, 0x74, 0x68, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4b, 0x65, 0x79, 0x49,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x62, 0x0a,
+	0x0d, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x3b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68,
+	0x41, 0x6c, 0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x14, 0x0a, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x03, 0x82, 0x4b, 0x00, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x22, 0x79,
+	0x0a, 0x05, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x75, 0x72, 0x73, 0x69, 0x76, 0x65, 0x22, 0xf8, 0x04, 0x0a, 0x07, 0x53, 0x63,
+	0x61, 0x6c, 0x61, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52,
+	0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0a,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x25, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x03, 0x82, 0x4b, 0x00,
+	0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x25, 0x0a,
+	0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x09, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x6f, 0x6f,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x09, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x0a, 0x05, 0x65, 0x6e, 0x75, 0x6d, 0x31,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x05, 0x65,
+	0x6e, 0x75, 0x6d, 0x31, 0x12, 0x23, 0x0a, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x73, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0a, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x03, 0x82, 0x4b, 0x00, 0x52, 0x09, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x12,
+	0x4d, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x61,
+	0x6c, 0x61, 0x72, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x12, 0x82, 0x4b, 0x0f, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2a,
+	0x0a, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61,
+	0x69, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x1a, 0x3c, 0x0a, 0x0e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x70, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb2, 0x01, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x19, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x05, 0x82, 0x4b, 0x02, 0x10, 0x01, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02,
+	0x10, 0x02, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1b, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x10, 0x03, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x69, 0x62, 0x61, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x10, 0x04, 0x52, 0x04, 0x69, 0x62, 0x61,
+	0x6e, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x03, 0x82, 0x4b, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x06, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x10,
+	0x02, 0x52, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xba, 0x02, 0x0a, 0x0a, 0x54, 0x72,
+	0x65, 0x61, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x07, 0x63, 0x6c, 0x65, 0x61,
+	0x72, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x18, 0x01,
+	0x52, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x73,
+	0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x82, 0x4b, 0x04, 0x18, 0x02,
+	0x30, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x06, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x18,
+	0x03, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x0b, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d,
+	0x82, 0x4b, 0x0a, 0x18, 0x04, 0x22, 0x06, 0x3c, 0x63, 0x61, 0x72, 0x64, 0x3e, 0x52, 0x0b, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0x82, 0x4b,
+	0x04, 0x18, 0x05, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x46, 0x6f, 0x75, 0x72, 0x12,
+	0x25, 0x0a, 0x0b, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x52, 0x0b, 0x75, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x18,
+	0x02, 0x52, 0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0b, 0x68, 0x61, 0x73, 0x68, 0x65, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x18, 0x03, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x22, 0xb1, 0x02, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x12, 0x37, 0x0a, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0xda, 0x50, 0x00, 0x52, 0x14, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x12, 0x4f, 0x0a,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x6e, 0x6f, 0x6e,
+	0x69, 0x63, 0x61, 0x6c, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x12, 0xda, 0x50, 0x0f, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x1f, 0x0a, 0x06, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xda, 0x50, 0x04, 0x18, 0x02, 0x30, 0x01, 0x52, 0x06, 0x6d, 0x61, 0x73, 0x6b, 0x65,
+	0x64, 0x12, 0x1b, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x05, 0xda, 0x50, 0x02, 0x10, 0x02, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x3a,
+	0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x42, 0x0a, 0x0b, 0x43, 0x61,
+	0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x3a, 0x03, 0x82, 0x4b, 0x00, 0x22, 0xf7,
+	0x02, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04,
+	0x63, 0x61, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x63, 0x61, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x63, 0x61, 0x72,
+	0x64, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x63, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b, 0x63, 0x61, 0x72, 0x64,
+	0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x6d, 0x61, 0x73, 0x6b, 0x65,
+	0x64, 0x43, 0x61, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x18, 0x02, 0x52, 0x0a, 0x6d, 0x61, 0x73, 0x6b,
+	0x65, 0x64, 0x43, 0x61, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x1a, 0x56, 0x0a, 0x10, 0x43, 0x61, 0x72, 0x64, 0x73, 0x42, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd8, 0x04, 0x0a, 0x05, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x03,
+	0x88, 0x4b, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x12, 0x34, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x70, 0x6c, 0x61, 0x69, 0x6e,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x48,
+	0x00, 0x52, 0x0c, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x29, 0x0a, 0x04, 0x6b, 0x65, 0x70, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x42,
+	0x03, 0x88, 0x4b, 0x01, 0x52, 0x04, 0x6b, 0x65, 0x70, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x03, 0x88, 0x4b, 0x01, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1d, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x05, 0x82, 0x4b, 0x02, 0x18, 0x02, 0x52, 0x06, 0x73, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x61, 0x72, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x61, 0x72, 0x64, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x04, 0x63, 0x61, 0x72, 0x64,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4b, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0xba, 0x02, 0x0a, 0x0d, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x32, 0x0a, 0x09, 0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73,
+	0x69, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x09,
+	0x64, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x12, 0x2e, 0x0a, 0x07, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73,
+	0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x3f, 0x0a, 0x07, 0x62, 0x79, 0x56,
+	0x69, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x2e, 0x42, 0x79, 0x56, 0x69, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x62, 0x79, 0x56, 0x69, 0x73, 0x69, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x64, 0x69, 0x63, 0x61, 0x6c, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x50,
+	0x0a, 0x0c, 0x42, 0x79, 0x56, 0x69, 0x73, 0x69, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2a, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x93, 0x01, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x03,
+	0x70, 0x61, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x70, 0x61, 0x6e,
+	0x12, 0x2c, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c,
+	0x61, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x25,
+	0x0a, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x03, 0x82, 0x4b, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x6f, 0x67, 0x72, 0x61, 0x6d, 0x42, 0x0f, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x05, 0x82, 0x4b, 0x02, 0x38, 0x01, 0x22, 0x96, 0x07, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x12, 0x60, 0x0a, 0x09, 0x62, 0x79, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x42, 0x79, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1e, 0x82, 0x4b, 0x1b, 0x0a,
+	0x07, 0x2a, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x0a, 0x08, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x5b, 0x30, 0x5d, 0x52, 0x09, 0x62, 0x79, 0x50, 0x61,
+	0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x62, 0x0a, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x26, 0x82, 0x4b, 0x23, 0x42, 0x0b, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x14, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x73, 0x69, 0x76, 0x65, 0x2e, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x64, 0x0a, 0x0c, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x19, 0x82, 0x4b, 0x16, 0x0a, 0x07, 0x2a, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x3f, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x2e, 0x54, 0x79, 0x70, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0c, 0x82, 0x4b,
+	0x09, 0x42, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x52, 0x04, 0x74, 0x79, 0x70, 0x6f,
+	0x12, 0x67, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x25, 0x82, 0x4b, 0x22, 0x42, 0x14, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x42, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x52, 0x09,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x1a, 0x63, 0x0a, 0x0e, 0x42, 0x79, 0x50,
+	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x6c,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4c,
+	0x0a, 0x0c, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69,
+	0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x51, 0x0a, 0x11,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x6c, 0x61, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x49, 0x0a, 0x09, 0x54, 0x79, 0x70, 0x6f, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x63, 0x0a, 0x0e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3b,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c,
+	0x6c, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x54, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xc4, 0x03, 0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x71, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x74, 0x74,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x32, 0x82, 0x4b, 0x2f, 0x0a, 0x0d, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x0a, 0x78, 0x2d, 0x61, 0x70, 0x69,
+	0x2d, 0x6b, 0x65, 0x79, 0x2a, 0x4a, 0x10, 0x5e, 0x78, 0x2d, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x2b,
+	0x2d, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x24, 0x50, 0x01, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x12, 0x4b, 0x0a, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6f, 0x6b,
+	0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0c, 0x82, 0x4b, 0x09, 0x4a, 0x07, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x12,
+	0x42, 0x0a, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x74, 0x74, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x06, 0x82, 0x4b, 0x03, 0x4a, 0x01, 0x28, 0x52, 0x06, 0x62, 0x72, 0x6f,
+	0x6b, 0x65, 0x6e, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x3a, 0x0a, 0x0c, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x42,
+	0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd3, 0x03, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0x82, 0x4b, 0x0f, 0x42, 0x0b, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x58, 0x01, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e,
+	0x50, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x0a, 0x82, 0x4b, 0x07, 0x0a, 0x03, 0x2a, 0x40, 0x2a, 0x58, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x47, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x2e,
+	0x50, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x07, 0x82,
+	0x4b, 0x04, 0x58, 0x01, 0x60, 0x01, 0x52, 0x08, 0x70, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65,
+	0x1a, 0x4c, 0x0a, 0x0c, 0x50, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c,
+	0x61, 0x69, 0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d,
+	0x0a, 0x0f, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4d, 0x0a,
+	0x0d, 0x50, 0x65, 0x72, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x26, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x69,
+	0x6e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x90, 0x04, 0x0a,
+	0x0d, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b,
+	0x0a, 0x12, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x64, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x61, 0x70, 0x4b,
+	0x65, 0x79, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x64, 0x61, 0x63, 0x74, 0x12, 0x29, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2f, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65,
+	0x67, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x68, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0b, 0x6b, 0x65, 0x65,
+	0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
+	0x6b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x69, 0x64, 0x65, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x6d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78,
+	0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x61, 0x70, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x67, 0x65, 0x78, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6d, 0x61, 0x70, 0x5f, 0x6b,
+	0x65, 0x79, 0x73, 0x5f, 0x63, 0x61, 0x73, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x6d, 0x61, 0x70, 0x4b,
+	0x65, 0x79, 0x73, 0x43, 0x61, 0x73, 0x65, 0x49, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x64,
+	0x61, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x4f, 0x6e, 0x4b, 0x65, 0x79, 0x43, 0x6f, 0x6c, 0x6c, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x2a,
+	0x2a, 0x0a, 0x05, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x45, 0x4e, 0x55,
+	0x4d, 0x5f, 0x31, 0x5f, 0x56, 0x41, 0x4c, 0x5f, 0x31, 0x10, 0x01, 0x2a, 0x69, 0x0a, 0x09, 0x44,
+	0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x69, 0x73, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x49, 0x41, 0x47,
+	0x4e, 0x4f, 0x53, 0x49, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x49, 0x41, 0x47, 0x4e, 0x4f, 0x53, 0x49, 0x53,
+	0x5f, 0x46, 0x4c, 0x55, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x0d, 0x44, 0x49, 0x41, 0x47, 0x4e, 0x4f,
+	0x53, 0x49, 0x53, 0x5f, 0x48, 0x49, 0x56, 0x10, 0x02, 0x1a, 0x03, 0x82, 0x4b, 0x00, 0x12, 0x16,
+	0x0a, 0x12, 0x44, 0x49, 0x41, 0x47, 0x4e, 0x4f, 0x53, 0x49, 0x53, 0x5f, 0x52, 0x45, 0x44, 0x41,
+	0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x66, 0x0a, 0x06, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x46, 0x4f, 0x52, 0x4d,
+	0x41, 0x54, 0x5f, 0x43, 0x41, 0x52, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x50, 0x48, 0x4f, 0x4e, 0x45, 0x10, 0x03, 0x12, 0x0f, 0x0a,
+	0x0b, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x49, 0x42, 0x41, 0x4e, 0x10, 0x04, 0x2a, 0x92,
+	0x01, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53,
+	0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47,
+	0x59, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x52,
+	0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4d, 0x41, 0x53, 0x4b, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x03, 0x12,
+	0x18, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x50, 0x4c, 0x41, 0x43,
+	0x45, 0x48, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x54, 0x52,
+	0x41, 0x54, 0x45, 0x47, 0x59, 0x5f, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f,
+	0x4e, 0x10, 0x05, 0x3a, 0x5f, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb0, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x3a, 0x3e, 0x0a, 0x0b, 0x73, 0x61, 0x66, 0x65, 0x5f, 0x74, 0x6f, 0x5f,
+	0x6c, 0x6f, 0x67, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xb1, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x73, 0x61, 0x66, 0x65, 0x54,
+	0x6f, 0x4c, 0x6f, 0x67, 0x3a, 0x67, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb0, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x10, 0x73, 0x65, 0x6e,
+	0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x5e, 0x0a,
+	0x0e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb0, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0d,
+	0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x3a, 0x65, 0x0a,
+	0x0f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0xb0, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76, 0x65, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x61, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x76,
+	0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb0, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x65, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x76, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x73, 0x69, 0x74, 0x69,
+	0x76, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testproto_testproto_proto_rawDescOnce sync.Once
+	file_testproto_testproto_proto_rawDescData = file_testproto_testproto_proto_rawDesc
+)
+
+func file_testproto_testproto_proto_rawDescGZIP() []byte {
+	file_testproto_testproto_proto_rawDescOnce.Do(func() {
+		file_testproto_testproto_proto_rawDescData = protoimpl.X.CompressGZIP(file_testproto_testproto_proto_rawDescData)
+	})
+	return file_testproto_testproto_proto_rawDescData
+}
+
+var file_testproto_testproto_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_testproto_testproto_proto_msgTypes = make([]protoimpl.MessageInfo, 38)
+var file_testproto_testproto_proto_goTypes = []interface{}{
+	(Enum1)(0),                            // 0: testproto.Enum1
+	(Diagnosis)(0),                        // 1: testproto.Diagnosis
+	(Format)(0),                           // 2: testproto.Format
+	(Strategy)(0),                         // 3: testproto.Strategy
+	(*WithAllFieldTypes)(nil),             // 4: testproto.WithAllFieldTypes
+	(*Plain)(nil),                         // 5: testproto.Plain
+	(*Scalars)(nil),                       // 6: testproto.Scalars
+	(*Contacts)(nil),                      // 7: testproto.Contacts
+	(*Treatments)(nil),                    // 8: testproto.Treatments
+	(*Canonical)(nil),                     // 9: testproto.Canonical
+	(*CardDetails)(nil),                   // 10: testproto.CardDetails
+	(*Order)(nil),                         // 11: testproto.Order
+	(*Event)(nil),                         // 12: testproto.Event
+	(*MedicalRecord)(nil),                 // 13: testproto.MedicalRecord
+	(*Payment)(nil),                       // 14: testproto.Payment
+	(*Accounts)(nil),                      // 15: testproto.Accounts
+	(*HttpRequest)(nil),                   // 16: testproto.HttpRequest
+	(*UserStats)(nil),                     // 17: testproto.UserStats
+	(*SensitiveData)(nil),                 // 18: testproto.SensitiveData
+	(*WithAllFieldTypes_Internal)(nil),    // 19: testproto.WithAllFieldTypes.Internal
+	nil,                                   // 20: testproto.WithAllFieldTypes.MapFieldEntry
+	nil,                                   // 21: testproto.WithAllFieldTypes.Internal.SensitiveMapEntry
+	nil,                                   // 22: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyEntry
+	nil,                                   // 23: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyIntKeyEntry
+	nil,                                   // 24: testproto.Scalars.StringMapEntry
+	nil,                                   // 25: testproto.Scalars.HeadersEntry
+	nil,                                   // 26: testproto.Canonical.HeadersEntry
+	nil,                                   // 27: testproto.Order.CardsByNameEntry
+	nil,                                   // 28: testproto.Event.LabelsEntry
+	nil,                                   // 29: testproto.Event.PlainsEntry
+	nil,                                   // 30: testproto.MedicalRecord.ByVisitEntry
+	nil,                                   // 31: testproto.Accounts.ByPatternEntry
+	nil,                                   // 32: testproto.Accounts.DetailsEntry
+	nil,                                   // 33: testproto.Accounts.TokenDetailsEntry
+	nil,                                   // 34: testproto.Accounts.TypoEntry
+	nil,                                   // 35: testproto.Accounts.InternalsEntry
+	nil,                                   // 36: testproto.HttpRequest.HeadersEntry
+	nil,                                   // 37: testproto.HttpRequest.CookiesEntry
+	nil,                                   // 38: testproto.HttpRequest.BrokenEntry
+	nil,                                   // 39: testproto.UserStats.PerUserEntry
+	nil,                                   // 40: testproto.UserStats.PerContactEntry
+	nil,                                   // 41: testproto.UserStats.PerPhoneEntry
+	(*descriptorpb.FieldOptions)(nil),     // 42: google.protobuf.FieldOptions
+	(*descriptorpb.MessageOptions)(nil),   // 43: google.protobuf.MessageOptions
+	(*descriptorpb.FileOptions)(nil),      // 44: google.protobuf.FileOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 45: google.protobuf.EnumValueOptions
+	(*descriptorpb.OneofOptions)(nil),     // 46: google.protobuf.OneofOptions
+}
+var file_testproto_testproto_proto_depIdxs = []int32{
+	19, // 0: testproto.WithAllFieldTypes.messageList:type_name -> testproto.WithAllFieldTypes.Internal
+	19, // 1: testproto.WithAllFieldTypes.messageListSensitive:type_name -> testproto.WithAllFieldTypes.Internal
+	0,  // 2: testproto.WithAllFieldTypes.enum1:type_name -> testproto.Enum1
+	0,  // 3: testproto.WithAllFieldTypes.enum1Sensitive:type_name -> testproto.Enum1
+	20, // 4: testproto.WithAllFieldTypes.mapField:type_name -> testproto.WithAllFieldTypes.MapFieldEntry
+	5,  // 5: testproto.WithAllFieldTypes.plainList:type_name -> testproto.Plain
+	5,  // 6: testproto.Plain.recursive:type_name -> testproto.Plain
+	0,  // 7: testproto.Scalars.enum1:type_name -> testproto.Enum1
+	24, // 8: testproto.Scalars.stringMap:type_name -> testproto.Scalars.StringMapEntry
+	25, // 9: testproto.Scalars.headers:type_name -> testproto.Scalars.HeadersEntry
+	26, // 10: testproto.Canonical.headers:type_name -> testproto.Canonical.HeadersEntry
+	10, // 11: testproto.Order.card:type_name -> testproto.CardDetails
+	10, // 12: testproto.Order.cards:type_name -> testproto.CardDetails
+	27, // 13: testproto.Order.cardsByName:type_name -> testproto.Order.CardsByNameEntry
+	10, // 14: testproto.Order.maskedCard:type_name -> testproto.CardDetails
+	11, // 15: testproto.Order.parent:type_name -> testproto.Order
+	28, // 16: testproto.Event.labels:type_name -> testproto.Event.LabelsEntry
+	29, // 17: testproto.Event.plains:type_name -> testproto.Event.PlainsEntry
+	5,  // 18: testproto.Event.plainSubject:type_name -> testproto.Plain
+	5,  // 19: testproto.Event.kept:type_name -> testproto.Plain
+	0,  // 20: testproto.Event.kind:type_name -> testproto.Enum1
+	10, // 21: testproto.Event.card:type_name -> testproto.CardDetails
+	1,  // 22: testproto.MedicalRecord.diagnosis:type_name -> testproto.Diagnosis
+	1,  // 23: testproto.MedicalRecord.history:type_name -> testproto.Diagnosis
+	30, // 24: testproto.MedicalRecord.byVisit:type_name -> testproto.MedicalRecord.ByVisitEntry
+	13, // 25: testproto.MedicalRecord.related:type_name -> testproto.MedicalRecord
+	5,  // 26: testproto.Payment.details:type_name -> testproto.Plain
+	31, // 27: testproto.Accounts.byPattern:type_name -> testproto.Accounts.ByPatternEntry
+	32, // 28: testproto.Accounts.details:type_name -> testproto.Accounts.DetailsEntry
+	33, // 29: testproto.Accounts.tokenDetails:type_name -> testproto.Accounts.TokenDetailsEntry
+	34, // 30: testproto.Accounts.typo:type_name -> testproto.Accounts.TypoEntry
+	35, // 31: testproto.Accounts.internals:type_name -> testproto.Accounts.InternalsEntry
+	36, // 32: testproto.HttpRequest.headers:type_name -> testproto.HttpRequest.HeadersEntry
+	37, // 33: testproto.HttpRequest.cookies:type_name -> testproto.HttpRequest.CookiesEntry
+	38, // 34: testproto.HttpRequest.broken:type_name -> testproto.HttpRequest.BrokenEntry
+	39, // 35: testproto.UserStats.perUser:type_name -> testproto.UserStats.PerUserEntry
+	40, // 36: testproto.UserStats.perContact:type_name -> testproto.UserStats.PerContactEntry
+	41, // 37: testproto.UserStats.perPhone:type_name -> testproto.UserStats.PerPhoneEntry
+	2,  // 38: testproto.SensitiveData.format:type_name -> testproto.Format
+	3,  // 39: testproto.SensitiveData.strategy:type_name -> testproto.Strategy
+	21, // 40: testproto.WithAllFieldTypes.Internal.sensitiveMap:type_name -> testproto.WithAllFieldTypes.Internal.SensitiveMapEntry
+	22, // 41: testproto.WithAllFieldTypes.Internal.mapWithSensitiveKey:type_name -> testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyEntry
+	23, // 42: testproto.WithAllFieldTypes.Internal.mapWithSensitiveKeyIntKey:type_name -> testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyIntKeyEntry
+	19, // 43: testproto.WithAllFieldTypes.Internal.recursive:type_name -> testproto.WithAllFieldTypes.Internal
+	19, // 44: testproto.WithAllFieldTypes.Internal.recursiveSensitive:type_name -> testproto.WithAllFieldTypes.Internal
+	19, // 45: testproto.WithAllFieldTypes.MapFieldEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
+	19, // 46: testproto.WithAllFieldTypes.Internal.SensitiveMapEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
+	19, // 47: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
+	19, // 48: testproto.WithAllFieldTypes.Internal.MapWithSensitiveKeyIntKeyEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
+	10, // 49: testproto.Order.CardsByNameEntry.value:type_name -> testproto.CardDetails
+	5,  // 50: testproto.Event.PlainsEntry.value:type_name -> testproto.Plain
+	1,  // 51: testproto.MedicalRecord.ByVisitEntry.value:type_name -> testproto.Diagnosis
+	19, // 52: testproto.Accounts.ByPatternEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
+	5,  // 53: testproto.Accounts.DetailsEntry.value:type_name -> testproto.Plain
+	5,  // 54: testproto.Accounts.TokenDetailsEntry.value:type_name -> testproto.Plain
+	5,  // 55: testproto.Accounts.TypoEntry.value:type_name -> testproto.Plain
+	19, // 56: testproto.Accounts.InternalsEntry.value:type_name -> testproto.WithAllFieldTypes.Internal
+	5,  // 57: testproto.UserStats.PerUserEntry.value:type_name -> testproto.Plain
+	5,  // 58: testproto.UserStats.PerPhoneEntry.value:type_name -> testproto.Plain
+	42, // 59: testproto.sensitive_data:extendee -> google.protobuf.FieldOptions
+	42, // 60: testproto.safe_to_log:extendee -> google.protobuf.FieldOptions
+	43, // 61: testproto.sensitive_message:extendee -> google.protobuf.MessageOptions
+	44, // 62: testproto.sensitive_file:extendee -> google.protobuf.FileOptions
+	45, // 63: testproto.sensitive_value:extendee -> google.protobuf.EnumValueOptions
+	46, // 64: testproto.sensitive_oneof:extendee -> google.protobuf.OneofOptions
+	18, // 65: testproto.sensitive_data:type_name -> testproto.SensitiveData
+	18, // 66: testproto.sensitive_message:type_name -> testproto.SensitiveData
+	18, // 67: testproto.sensitive_file:type_name -> testproto.SensitiveData
+	18, // 68: testproto.sensitive_value:type_name -> testproto.SensitiveData
+	18, // 69: testproto.sensitive_oneof:type_name -> testproto.SensitiveData
+	70, // [70:70] is the sub-list for method output_type
+	70, // [70:70] is the sub-list for method input_type
+	65, // [65:70] is the sub-list for extension type_name
+	59, // [59:65] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_testproto_testproto_proto_init() }
+func file_testproto_testproto_proto_init() {
+	if File_testproto_testproto_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_testproto_testproto_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WithAllFieldTypes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Plain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 		file_testproto_testproto_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scalars); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Contacts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Treatments); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Canonical); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CardDetails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MedicalRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Payment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Accounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HttpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SensitiveData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testproto_testproto_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WithAllFieldTypes_Internal); i {
 			case 0:
 				return &v.state
@@ -623,14 +2744,23 @@ func file_testproto_testproto_proto_init() {
 		(*WithAllFieldTypes_Token)(nil),
 		(*WithAllFieldTypes_Cryptogram)(nil),
 	}
+	file_testproto_testproto_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*Event_UserId)(nil),
+		(*Event_PlainSubject)(nil),
+	}
+	file_testproto_testproto_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*Payment_Pan)(nil),
+		(*Payment_Details)(nil),
+		(*Payment_Cryptogram)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_testproto_testproto_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   7,
-			NumExtensions: 1,
+			NumEnums:      4,
+			NumMessages:   38,
+			NumExtensions: 6,
 			NumServices:   0,
 		},
 		GoTypes:           file_testproto_testproto_proto_goTypes,
@@ -0,0 +1,49 @@
+package protoredact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+)
+
+// SensitiveDataError lists populated sensitive locations found by Verify
+type SensitiveDataError struct {
+	Findings []Finding
+}
+
+// Error lists the paths of findings, sensitive keys are not named: only the map holding them is
+func (e *SensitiveDataError) Error() string {
+	paths := make([]string, len(e.Findings))
+	for i, f := range e.Findings {
+		if f.Key {
+			paths[i] = f.Path[:len(f.Path)-1].String() + "[<key>]"
+			continue
+		}
+		paths[i] = f.Path.String()
+	}
+	return "protoredact: message contains sensitive data: " + strings.Join(paths, ", ")
+}
+
+// Verify returns *SensitiveDataError if msg still has populated fields or map keys which Redact would redact.
+// Keys of redact_map_keys maps are reported while they match map_keys_to_redact or map_key_regexps,
+// keys of maps rewriting all keys cannot be told from rewritten ones, so they are not reported
+func (r Redactor) Verify(msg proto.Message) error {
+	all, err := r.Inspect(msg)
+	if err != nil {
+		return err
+	}
+	var findings []Finding
+	for _, f := range all {
+		if !f.allKeys {
+			findings = append(findings, f)
+		}
+	}
+	if len(findings) > 0 {
+		return &SensitiveDataError{Findings: findings}
+	}
+	return nil
+}
+
+func Verify(msg proto.Message, sensitiveFieldAnnotation protoreflect.ExtensionType) error {
+	return Redactor{SensitiveFieldAnnotation: sensitiveFieldAnnotation}.Verify(msg)
+}
@@ -0,0 +1,37 @@
+package protoredact
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/yonesko/protoredact/testproto/go/testproto"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+	message := &testproto.WithAllFieldTypes{
+		FieldInt64:           418,
+		FieldStringSensitive: "pad",
+		MessageList: []*testproto.WithAllFieldTypes_Internal{
+			{MapWithSensitiveKey: map[string]*testproto.WithAllFieldTypes_Internal{
+				"detail":        {FieldInt64: 948},
+				"hide_this_key": {FieldInt64: 999},
+			}},
+			{MapWithSensitiveKeyIntKey: map[int64]*testproto.WithAllFieldTypes_Internal{87654: {FieldInt64: 948}}},
+		},
+	}
+
+	err := Verify(message, testproto.E_SensitiveData)
+
+	var sensitiveDataError *SensitiveDataError
+	assert.True(t, errors.As(err, &sensitiveDataError))
+	assert.Len(t, sensitiveDataError.Findings, 3)
+	assert.EqualError(t, err, "protoredact: message contains sensitive data: "+
+		"(testproto.WithAllFieldTypes).fieldStringSensitive, "+
+		"(testproto.WithAllFieldTypes).messageList[0].mapWithSensitiveKey[\"hide_this_key\"], "+
+		"(testproto.WithAllFieldTypes).messageList[1].mapWithSensitiveKeyIntKey[87654]")
+
+	assert.NoError(t, Redact(message, testproto.E_SensitiveData))
+	assert.NoError(t, Verify(message, testproto.E_SensitiveData))
+	assert.NoError(t, Verify(&testproto.WithAllFieldTypes{FieldInt64: 418}, testproto.E_SensitiveData))
+}
@@ -0,0 +1,248 @@
+package protoredact
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protopath"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sort"
+)
+
+// location is a populated sensitive place of a message
+type location struct {
+	// path leads to the field value, or to the map entry when key is valid
+	path   protopath.Values
+	parent protoreflect.Message
+	field  protoreflect.FieldDescriptor
+	plan   fieldPlan
+	// key is valid when only this entry of the field map is sensitive
+	key protoreflect.MapKey
+	// index is the index of the sensitive element of the field list, -1 when not a list element
+	index int
+	// enumValue means the current enum value is sensitive rather than the field
+	enumValue bool
+	// keys of the field map are sensitive themselves in key order, path leads to the map
+	keys   []protoreflect.MapKey
+	reason string
+}
+
+// annotation returns the annotation making the location sensitive
+func (loc location) annotation() proto.Message {
+	if loc.enumValue {
+		return loc.plan.enumValues[loc.path.Index(-1).Value.Enum()]
+	}
+	return loc.plan.annotation
+}
+
+// walker finds sensitive locations following compiled plans,
+// visit may mutate the location, walker does not descend into visited locations
+type walker struct {
+	redactor Redactor
+	visit    func(loc location) error
+	// stable makes map entries visited in key order
+	stable bool
+	// clearUnknown drops unknown fields of walked messages, see Redactor.Allowlist
+	clearUnknown bool
+}
+
+func (w walker) message(path protopath.Values, m protoreflect.Message) error {
+	p := planFor(m.Descriptor(), w.redactor.annotations())
+	if !p.reachable {
+		return nil
+	}
+	if w.clearUnknown && len(m.GetUnknown()) > 0 {
+		w.redactor.logRedacted(path.Path, reasonUnknownFields)
+		m.SetUnknown(nil)
+	}
+	fields, values := p.populated(m)
+	for i, fd := range fields {
+		if err := w.field(appendStep(path, protopath.FieldAccess(fd), values[i]), m, fd, p.field(fd), values[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// field walks the populated field fd of m with value v, path leads to v
+func (w walker) field(path protopath.Values, m protoreflect.Message, fd protoreflect.FieldDescriptor, fp fieldPlan, v protoreflect.Value) error {
+	if fp.sensitive {
+		return w.visit(location{path: path, parent: m, field: fd, plan: fp, index: -1, reason: fp.reason})
+	}
+	var visited map[interface{}]bool
+	if fp.byEntry() {
+		var err error
+		if visited, err = w.mapKeys(path, m, fd, fp, v.Map()); err != nil {
+			return err
+		}
+	}
+	if len(fp.enumValues) > 0 {
+		if err := w.enumValues(path, m, fd, fp, v); err != nil {
+			return err
+		}
+	}
+	if !fp.descend {
+		return nil
+	}
+	return w.value(path, fd, v, visited)
+}
+
+// mapKeys visits entries matching fp and returns their keys,
+// when only map_value_fields are redacted it visits those sub-fields instead and the entries stay open for descending.
+// Keys to rewrite are visited at once before the values, so the walk goes on with rewritten keys
+func (w walker) mapKeys(path protopath.Values, m protoreflect.Message, fd protoreflect.FieldDescriptor, fp fieldPlan, valueMap protoreflect.Map) (map[interface{}]bool, error) {
+	if fp.redactKeys {
+		var keys []protoreflect.MapKey
+		valueMap.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			if w.redactor.restoreMapKeys || fp.matchKey(key.String()) {
+				keys = append(keys, key)
+			}
+			return true
+		})
+		if len(keys) > 0 {
+			sort.Slice(keys, func(i, j int) bool {
+				return lessMapKey(keys[i], keys[j])
+			})
+			if err := w.visit(location{path: path, parent: m, field: fd, plan: fp, index: -1, keys: keys, reason: reasonMapKeyRewritten}); err != nil {
+				return nil, err
+			}
+			valueMap = m.Get(fd).Map()
+		}
+	}
+	var keys []protoreflect.MapKey
+	w.rangeMap(valueMap, func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		if fp.matchValue(key.String()) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	visited := make(map[interface{}]bool, len(keys))
+	for _, key := range keys {
+		entryPath := appendStep(path, protopath.MapIndex(key), valueMap.Get(key))
+		if fp.valuesOnly {
+			err := fp.rangeValueFields(entryPath, valueMap.Get(key).Message(), func(path protopath.Values, parent protoreflect.Message, fields []protoreflect.FieldDescriptor) error {
+				return w.visit(location{
+					path:   path,
+					parent: parent,
+					field:  fields[len(fields)-1],
+					plan:   fp,
+					index:  -1,
+					reason: reasonMapValueField,
+				})
+			})
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		visited[key.Interface()] = true
+		loc := location{
+			path:   entryPath,
+			parent: m,
+			field:  fd,
+			plan:   fp,
+			key:    key,
+			index:  -1,
+			reason: reasonMapKey,
+		}
+		if err := w.visit(loc); err != nil {
+			return nil, err
+		}
+	}
+	return visited, nil
+}
+
+// enumValues visits values of the enum field fd which are listed in enumValues: the field itself, list elements or map values
+func (w walker) enumValues(path protopath.Values, m protoreflect.Message, fd protoreflect.FieldDescriptor, fp fieldPlan, v protoreflect.Value) error {
+	var locations []location
+	add := func(path protopath.Values, key protoreflect.MapKey, index int, v protoreflect.Value) {
+		if _, ok := fp.enumValues[v.Enum()]; ok {
+			locations = append(locations, location{
+				path:      path,
+				parent:    m,
+				field:     fd,
+				plan:      fp,
+				key:       key,
+				index:     index,
+				enumValue: true,
+				reason:    reasonEnumValue,
+			})
+		}
+	}
+	switch {
+	case fd.IsList():
+		for i := 0; i < v.List().Len(); i++ {
+			add(copyValues(appendStep(path, protopath.ListIndex(i), v.List().Get(i))), protoreflect.MapKey{}, i, v.List().Get(i))
+		}
+	case fd.IsMap():
+		w.rangeMap(v.Map(), func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			add(copyValues(appendStep(path, protopath.MapIndex(key), value)), key, -1, value)
+			return true
+		})
+	default:
+		add(path, protoreflect.MapKey{}, -1, v)
+	}
+	for _, loc := range locations {
+		if err := w.visit(loc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// value descends into messages held by fd skipping map entries with visited keys
+func (w walker) value(path protopath.Values, fd protoreflect.FieldDescriptor, v protoreflect.Value, visited map[interface{}]bool) error {
+	switch {
+	case fd.IsList():
+		for i := 0; i < v.List().Len(); i++ {
+			elem := v.List().Get(i)
+			if err := w.message(appendStep(path, protopath.ListIndex(i), elem), elem.Message()); err != nil {
+				return err
+			}
+		}
+	case fd.IsMap():
+		var err error
+		w.rangeMap(v.Map(), func(key protoreflect.MapKey, value protoreflect.Value) bool {
+			if visited[key.Interface()] {
+				return true
+			}
+			err = w.message(appendStep(path, protopath.MapIndex(key), value), value.Message())
+			return err == nil
+		})
+		return err
+	default:
+		return w.message(path, v.Message())
+	}
+	return nil
+}
+
+func (w walker) rangeMap(m protoreflect.Map, f func(protoreflect.MapKey, protoreflect.Value) bool) {
+	if !w.stable {
+		m.Range(f)
+		return
+	}
+	keys := make([]protoreflect.MapKey, 0, m.Len())
+	m.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+		keys = append(keys, key)
+		return true
+	})
+	sort.Slice(keys, func(i, j int) bool {
+		return lessMapKey(keys[i], keys[j])
+	})
+	for _, key := range keys {
+		if !f(key, m.Get(key)) {
+			return
+		}
+	}
+}
+
+func lessMapKey(a, b protoreflect.MapKey) bool {
+	switch a.Interface().(type) {
+	case bool:
+		return !a.Bool() && b.Bool()
+	case int32, int64:
+		return a.Int() < b.Int()
+	case uint32, uint64:
+		return a.Uint() < b.Uint()
+	default:
+		return a.String() < b.String()
+	}
+}